@@ -0,0 +1,48 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/jguerreno/JSON-Converter/converter"
+	"github.com/jguerreno/JSON-Converter/internal/diff"
+)
+
+// checkOutput compares the generated files with those under output without
+// writing anything. Differences are printed to stdout as a unified diff and
+// make checkOutput fail, so that CI can catch models that are out of date.
+func checkOutput(output string, files []converter.GeneratedFile, stdout io.Writer) error {
+	if output == "" {
+		return errors.New("-check needs the output to compare with, use -o")
+	}
+
+	stale := 0
+	for _, file := range files {
+		path := output
+		if len(files) > 1 {
+			path = filepath.Join(output, file.Name)
+		}
+
+		oldName := path
+		existing, err := os.ReadFile(path)
+		if errors.Is(err, fs.ErrNotExist) {
+			oldName = "/dev/null"
+		} else if err != nil {
+			return fmt.Errorf("error reading output file: %w", err)
+		}
+
+		if d := diff.Unified(oldName, path, string(existing), file.Content); d != "" {
+			fmt.Fprint(stdout, d)
+			stale++
+		}
+	}
+
+	if stale > 0 {
+		return fmt.Errorf("%d of %d generated files are out of date, run without -check to update them", stale, len(files))
+	}
+	return nil
+}
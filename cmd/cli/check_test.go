@@ -0,0 +1,72 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/jguerreno/JSON-Converter/converter"
+)
+
+func TestRunCLI_Check(t *testing.T) {
+	dir := t.TempDir()
+	input := filepath.Join(dir, "user.json")
+	output := filepath.Join(dir, "user.go")
+	if err := os.WriteFile(input, []byte(`{"name": "alice"}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	args := []string{"cmd", "-i", input, "-o", output, "-r", "User"}
+
+	if err := runCLI(args, strings.NewReader(""), &bytes.Buffer{}, &bytes.Buffer{}); err != nil {
+		t.Fatalf("runCLI failed: %v", err)
+	}
+
+	stdout := &bytes.Buffer{}
+	if err := runCLI(append(args, "-check"), strings.NewReader(""), stdout, &bytes.Buffer{}); err != nil {
+		t.Fatalf("expected up to date output to pass -check, got: %v\n%s", err, stdout.String())
+	}
+	if stdout.Len() != 0 {
+		t.Errorf("expected no diff, got:\n%s", stdout.String())
+	}
+
+	if err := os.WriteFile(input, []byte(`{"name": "alice", "age": 30}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	before, _ := os.ReadFile(output)
+	err := runCLI(append(args, "-check"), strings.NewReader(""), stdout, &bytes.Buffer{})
+	if err == nil || !strings.Contains(err.Error(), "out of date") {
+		t.Errorf("expected stale output to fail -check, got: %v", err)
+	}
+	if !strings.Contains(stdout.String(), "--- "+output) || !strings.Contains(stdout.String(), "+    Age int") {
+		t.Errorf("expected a diff adding Age, got:\n%s", stdout.String())
+	}
+	if after, _ := os.ReadFile(output); !bytes.Equal(before, after) {
+		t.Error("-check must not write the output")
+	}
+}
+
+func TestCheckOutput(t *testing.T) {
+	dir := t.TempDir()
+	files := []converter.GeneratedFile{
+		{Name: "a.tf", Content: "a\n"},
+		{Name: "b.tf", Content: "b\n"},
+	}
+	if err := os.WriteFile(filepath.Join(dir, "a.tf"), []byte("a\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	stdout := &bytes.Buffer{}
+	err := checkOutput(dir, files, stdout)
+	if err == nil || !strings.Contains(err.Error(), "1 of 2 generated files") {
+		t.Errorf("expected the missing file to be reported, got: %v", err)
+	}
+	if !strings.Contains(stdout.String(), "--- /dev/null\n+++ "+filepath.Join(dir, "b.tf")) {
+		t.Errorf("expected a diff creating b.tf, got:\n%s", stdout.String())
+	}
+
+	if err := checkOutput("", files, stdout); err == nil || !strings.Contains(err.Error(), "use -o") {
+		t.Errorf("expected an error asking for -o, got %v", err)
+	}
+}
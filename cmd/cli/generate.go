@@ -0,0 +1,242 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"runtime"
+	"sync"
+	"time"
+
+	"github.com/jguerreno/JSON-Converter/converter"
+)
+
+type GenerateConfig struct {
+	ConfigFile string
+	Parallel   int
+	Check      bool
+	Jobs       []string
+}
+
+func parseGenerateFlags(args []string, stderr io.Writer) (*GenerateConfig, error) {
+	config := &GenerateConfig{}
+
+	fs := flag.NewFlagSet(args[0]+" generate", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+
+	fs.StringVar(&config.ConfigFile, "config", "", "Project config file (default: json-converter.yaml, .yml or .json in the current directory)")
+	fs.StringVar(&config.ConfigFile, "c", "", "Project config file (shorthand)")
+	fs.IntVar(&config.Parallel, "parallel", runtime.NumCPU(), "Maximum number of targets generated at once")
+	fs.BoolVar(&config.Check, "check", false, "Compare every target with its existing output instead of writing it")
+
+	fs.Usage = func() {
+		fmt.Fprintf(stderr, "Run the jobs of a project config\n\n")
+		fmt.Fprintf(stderr, "Usage:\n")
+		fmt.Fprintf(stderr, "  %s generate [options] [job...]\n\n", args[0])
+		fmt.Fprintf(stderr, "Runs every job, or only the named ones.\n\n")
+		fmt.Fprintf(stderr, "Options:\n")
+		fs.PrintDefaults()
+	}
+
+	if err := fs.Parse(args[2:]); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to parse flags: %w", err)
+	}
+	if config.Parallel < 1 {
+		return nil, fmt.Errorf("-parallel must be at least 1, got %d", config.Parallel)
+	}
+	config.Jobs = fs.Args()
+	return config, nil
+}
+
+// generateTask is one target of one job, with the output it produced.
+type generateTask struct {
+	job    string
+	config *CLIConfig
+	stdout bytes.Buffer
+	stderr bytes.Buffer
+	err    error
+}
+
+// runGenerate runs the targets of the selected jobs in parallel. Their
+// messages are printed in config order once all are done, followed by a
+// summary; any failed target makes the command fail.
+func runGenerate(ctx context.Context, args []string, stdout, stderr io.Writer) error {
+	config, err := parseGenerateFlags(args, stderr)
+	if err != nil || config == nil {
+		return err
+	}
+
+	path := config.ConfigFile
+	if path == "" {
+		if path, err = findProjectConfig("."); err != nil {
+			return err
+		}
+	}
+	project, err := loadProjectConfig(path)
+	if err != nil {
+		return err
+	}
+
+	tasks, err := generateTasks(project, config)
+	if err != nil {
+		return err
+	}
+
+	start := time.Now()
+	cache := newJobCache()
+	var wg sync.WaitGroup
+	slots := make(chan struct{}, config.Parallel)
+	for _, task := range tasks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			slots <- struct{}{}
+			defer func() { <-slots }()
+			task.err = runJob(ctx, task.config, cache, nil, &task.stdout, &task.stderr)
+		}()
+	}
+	wg.Wait()
+
+	failed := 0
+	jobs := map[string]bool{}
+	for _, task := range tasks {
+		jobs[task.job] = true
+		io.Copy(stdout, &task.stdout)
+		io.Copy(stderr, &task.stderr)
+		if task.err != nil {
+			failed++
+			fmt.Fprintf(stderr, "Error: job '%s', %s: %v\n", task.job, task.config.Language, task.err)
+		}
+	}
+
+	fmt.Fprintf(stderr, "%d jobs, %d targets: %d succeeded, %d failed in %s\n",
+		len(jobs), len(tasks), len(tasks)-failed, failed, time.Since(start).Round(time.Millisecond))
+	if failed > 0 {
+		return fmt.Errorf("%d of %d targets failed", failed, len(tasks))
+	}
+	return nil
+}
+
+// jobCache shares work between the targets of a generate run: inputs read
+// with the same settings are parsed once, and the templates and plugins of
+// a registry are loaded once. Its methods work on a nil cache, without
+// sharing anything.
+type jobCache struct {
+	mu         sync.Mutex
+	models     map[string]*cachedModel
+	registries map[string]*cachedRegistry
+}
+
+type cachedModel struct {
+	once    sync.Once
+	classes []converter.ClassDefinition
+	format  converter.Format
+	err     error
+}
+
+type cachedRegistry struct {
+	once     sync.Once
+	registry *converter.Registry
+	err      error
+
+	pluginsOnce sync.Once
+	pluginsErr  error
+}
+
+func newJobCache() *jobCache {
+	return &jobCache{
+		models:     map[string]*cachedModel{},
+		registries: map[string]*cachedRegistry{},
+	}
+}
+
+// parse returns the model of the input of config, parsing it only for the
+// first job that reads it with the same format, root and naming.
+func (c *jobCache) parse(ctx context.Context, config *CLIConfig, stdin io.Reader) ([]converter.ClassDefinition, converter.Format, error) {
+	if c == nil || config.InputFile == "" {
+		return parseInput(ctx, config, stdin)
+	}
+
+	// fmt prints maps with sorted keys, so equal settings give equal keys.
+	key := fmt.Sprintf("%s\x00%s\x00%s\x00%v\x00%v", config.InputFile, config.InputFormat, config.RootName, config.Naming, config.Overrides)
+	c.mu.Lock()
+	model, ok := c.models[key]
+	if !ok {
+		model = &cachedModel{}
+		c.models[key] = model
+	}
+	c.mu.Unlock()
+
+	model.once.Do(func() {
+		model.classes, model.format, model.err = parseInput(ctx, config, stdin)
+	})
+	return model.classes, model.format, model.err
+}
+
+// registry returns the registry for template and pluginDir, running the
+// plugins at most once and only when language needs them.
+func (c *jobCache) registry(template, pluginDir, language string, stderr io.Writer) (*converter.Registry, error) {
+	if c == nil {
+		return newRegistry(template, pluginDir, language, stderr)
+	}
+
+	key := template + "\x00" + pluginDir
+	c.mu.Lock()
+	cached, ok := c.registries[key]
+	if !ok {
+		cached = &cachedRegistry{}
+		c.registries[key] = cached
+	}
+	c.mu.Unlock()
+
+	cached.once.Do(func() {
+		cached.registry, cached.err = loadTemplates(template)
+	})
+	if cached.err != nil {
+		return nil, cached.err
+	}
+	if needsPlugins(language, cached.registry) {
+		cached.pluginsOnce.Do(func() {
+			cached.pluginsErr = discoverPlugins(cached.registry, pluginDir, stderr)
+		})
+		if cached.pluginsErr != nil {
+			return nil, cached.pluginsErr
+		}
+	}
+	return cached.registry, nil
+}
+
+// generateTasks lists the targets of the jobs named in config, or of every
+// job when none is named.
+func generateTasks(project *ProjectConfig, config *GenerateConfig) ([]*generateTask, error) {
+	selected := map[string]bool{}
+	for _, name := range config.Jobs {
+		selected[name] = false
+	}
+
+	var tasks []*generateTask
+	for _, job := range project.Jobs {
+		if _, ok := selected[job.Name]; len(config.Jobs) > 0 && !ok {
+			continue
+		}
+		selected[job.Name] = true
+		for _, target := range job.Targets {
+			cliConfig := job.cliConfig(target)
+			cliConfig.Check = config.Check
+			tasks = append(tasks, &generateTask{job: job.Name, config: cliConfig})
+		}
+	}
+
+	for _, name := range config.Jobs {
+		if !selected[name] {
+			return nil, fmt.Errorf("no job named '%s'", name)
+		}
+	}
+	return tasks, nil
+}
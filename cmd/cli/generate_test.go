@@ -0,0 +1,130 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRunGenerate(t *testing.T) {
+	dir := t.TempDir()
+	writeProjectFile(t, dir, "samples/users/a.json", `{"id": 1, "profile": {"bio": "x"}}`)
+	writeProjectFile(t, dir, "samples/users/b.json", `{"id": 2, "name": "bob"}`)
+	writeProjectFile(t, dir, "samples/service.yaml", "name: api\n")
+	config := writeProjectFile(t, dir, "json-converter.yaml", `
+jobs:
+  - name: users
+    input: samples/users
+    root: User
+    naming: {prefix: Api}
+    types: {Profile: UserProfile}
+    targets:
+      - {language: go, output: gen/user.go}
+      - {language: typescript, output: gen/user.ts}
+  - name: service
+    input: samples/service.yaml
+    root: Service
+    targets:
+      - {language: python, output: gen/service.py}
+`)
+
+	stderr := &bytes.Buffer{}
+	if err := runCLI([]string{"cmd", "generate", "-config", config}, strings.NewReader(""), &bytes.Buffer{}, stderr); err != nil {
+		t.Fatalf("generate failed: %v\n%s", err, stderr.String())
+	}
+	if !strings.Contains(stderr.String(), "2 jobs, 3 targets: 3 succeeded, 0 failed") {
+		t.Errorf("unexpected summary:\n%s", stderr.String())
+	}
+
+	content, err := os.ReadFile(filepath.Join(dir, "gen/user.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"type ApiUser struct", "type UserProfile struct", "Name *string"} {
+		if !strings.Contains(string(content), want) {
+			t.Errorf("expected %q in gen/user.go:\n%s", want, content)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(dir, "gen/service.py")); err != nil {
+		t.Errorf("expected gen/service.py to be written: %v", err)
+	}
+
+	writeProjectFile(t, dir, "samples/service.yaml", "name: api\nport: 80\n")
+	stdout := &bytes.Buffer{}
+	stderr.Reset()
+	err = runCLI([]string{"cmd", "generate", "-config", config, "-check"}, strings.NewReader(""), stdout, stderr)
+	if err == nil || !strings.Contains(err.Error(), "1 of 3 targets failed") {
+		t.Errorf("expected the stale service target to fail -check, got: %v", err)
+	}
+	if !strings.Contains(stdout.String(), "+    port: int") {
+		t.Errorf("expected a diff adding port, got:\n%s", stdout.String())
+	}
+
+	stderr.Reset()
+	if err := runCLI([]string{"cmd", "generate", "-config", config, "-check", "users"}, strings.NewReader(""), &bytes.Buffer{}, stderr); err != nil {
+		t.Errorf("expected the users job alone to pass -check, got: %v\n%s", err, stderr.String())
+	}
+}
+
+func TestRunGenerateErrors(t *testing.T) {
+	dir := t.TempDir()
+	writeProjectFile(t, dir, "a.json", `{"id": 1}`)
+	config := writeProjectFile(t, dir, "json-converter.yaml", `
+jobs:
+  - name: a
+    input: a.json
+    targets:
+      - {language: go, output: a.go}
+      - {language: cobol, output: a.cbl}
+`)
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{"failed target", []string{"cmd", "generate", "-c", config}, "1 of 2 targets failed"},
+		{"unknown job", []string{"cmd", "generate", "-c", config, "b"}, "no job named 'b'"},
+		{"missing config", []string{"cmd", "generate", "-c", filepath.Join(dir, "missing.yaml")}, "error reading config"},
+		{"invalid parallel", []string{"cmd", "generate", "-parallel", "0"}, "-parallel must be at least 1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := runCLI(tt.args, strings.NewReader(""), &bytes.Buffer{}, &bytes.Buffer{})
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("runCLI() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestJobCacheParsesSharedInputsOnce(t *testing.T) {
+	dir := t.TempDir()
+	input := writeProjectFile(t, dir, "user.json", `{"id": 1}`)
+	cache := newJobCache()
+
+	first, _, err := cache.parse(context.Background(), &CLIConfig{InputFile: input, RootName: "User"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	writeProjectFile(t, dir, "user.json", `{"id": 1, "name": "ann"}`)
+	second, _, err := cache.parse(context.Background(), &CLIConfig{InputFile: input, RootName: "User"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(second[0].Fields) != 1 || &first[0] != &second[0] {
+		t.Errorf("expected the second job to reuse the parsed model, got %+v", second)
+	}
+
+	other, _, err := cache.parse(context.Background(), &CLIConfig{InputFile: input, RootName: "Account"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if other[0].Name != "Account" || len(other[0].Fields) != 2 {
+		t.Errorf("expected a different root to parse the input again, got %+v", other)
+	}
+}
@@ -1,41 +1,106 @@
 package main
 
 import (
+	"context"
 	"errors"
 	"flag"
 	"fmt"
 	"io"
 	"os"
+	"os/signal"
+	"path/filepath"
+	"sort"
+	"strings"
+	"syscall"
 
-	"github.com/jguerreno/JSON-Converter/internal/generator"
+	"github.com/jguerreno/JSON-Converter/converter"
 )
 
 type CLIConfig struct {
-	InputFile  string
-	OutputFile string
-	Language   string
-	RootName   string
+	InputFile   string
+	OutputFile  string
+	Language    string
+	RootName    string
+	InputFormat string
+	Template    string
+	PluginDir   string
+	Watch       bool
+	Check       bool
+	Naming      converter.Naming
+	Overrides   map[string]string
+	Options     converter.Options
+}
+
+// optionsFlag collects repeated -opt key=value flags into generator options.
+type optionsFlag converter.Options
+
+func (o optionsFlag) String() string {
+	pairs := make([]string, 0, len(o))
+	for key, value := range o {
+		pairs = append(pairs, key+"="+value)
+	}
+	return strings.Join(pairs, ",")
+}
+
+func (o optionsFlag) Set(value string) error {
+	key, val, ok := strings.Cut(value, "=")
+	if !ok || key == "" {
+		return fmt.Errorf("expected key=value, got %q", value)
+	}
+	o[key] = val
+	return nil
+}
+
+// overridesFlag collects repeated -override path=type flags. The path is
+// split off at the last "=", since bracketed keys may contain one.
+type overridesFlag map[string]string
+
+func (o overridesFlag) String() string {
+	pairs := make([]string, 0, len(o))
+	for path, spec := range o {
+		pairs = append(pairs, path+"="+spec)
+	}
+	return strings.Join(pairs, ",")
+}
+
+func (o overridesFlag) Set(value string) error {
+	i := strings.LastIndex(value, "=")
+	if i <= 0 {
+		return fmt.Errorf("expected path=type, got %q", value)
+	}
+	o[value[:i]] = value[i+1:]
+	return nil
 }
 
 func parseCLIFlags(args []string, stderr io.Writer) (*CLIConfig, error) {
-	config := &CLIConfig{}
+	config := &CLIConfig{Options: converter.Options{}, Overrides: map[string]string{}}
 
 	fs := flag.NewFlagSet(args[0], flag.ContinueOnError)
 	fs.SetOutput(stderr)
 
-	fs.StringVar(&config.InputFile, "input", "", "Input JSON file (required, or use stdin)")
-	fs.StringVar(&config.InputFile, "i", "", "Input JSON file (shorthand)")
+	fs.StringVar(&config.InputFile, "input", "", "Input file, or directory of sample files (default: stdin)")
+	fs.StringVar(&config.InputFile, "i", "", "Input file or directory (shorthand)")
 	fs.StringVar(&config.OutputFile, "output", "", "Output file (optional, default: stdout)")
 	fs.StringVar(&config.OutputFile, "o", "", "Output file (shorthand)")
-	fs.StringVar(&config.Language, "lang", "go", "Target language: go, python, typescript, java")
+	fs.StringVar(&config.Language, "lang", "go", "Target languages, comma separated, or all: go, python, typescript, java, proto, graphql, sql, avro, parquet, jsonschema")
 	fs.StringVar(&config.Language, "l", "go", "Target language (shorthand)")
 	fs.StringVar(&config.RootName, "root", "Root", "Root struct/class name")
 	fs.StringVar(&config.RootName, "r", "Root", "Root name (shorthand)")
+	fs.StringVar(&config.InputFormat, "format", "", "Input format: json, yaml, toml, xml, csv, tsv, openapi (default: from file extension, else json)")
+	fs.StringVar(&config.InputFormat, "f", "", "Input format (shorthand)")
+	fs.StringVar(&config.Template, "template", "", "Template file or directory overriding a language or adding a new one")
+	fs.StringVar(&config.PluginDir, "plugins", "", "Directory searched before PATH for json-converter-gen-<lang> plugins")
+	fs.BoolVar(&config.Watch, "watch", false, "Regenerate the output whenever the input file or directory changes")
+	fs.BoolVar(&config.Check, "check", false, "Compare with the existing output instead of writing it; print a diff and fail if they differ")
+	fs.Var(overridesFlag(config.Overrides), "override", "Type or class name for a JSONPath as path=type, repeatable (e.g. $.created_at=datetime, $.items[*]=OrderLine, $.legacy=skip)")
+	fs.Var(optionsFlag(config.Options), "opt", "Generator option as key=value, repeatable (e.g. tags=yaml)")
 
 	fs.Usage = func() {
 		fmt.Fprintf(stderr, "JSON Code Generator - Convert JSON to Go/Python/TypeScript/Java\n\n")
 		fmt.Fprintf(stderr, "Usage:\n")
-		fmt.Fprintf(stderr, "  %s [options]\n\n", args[0])
+		fmt.Fprintf(stderr, "  %s [options]\n", args[0])
+		fmt.Fprintf(stderr, "  %s generate [options] [job...]\n", args[0])
+		fmt.Fprintf(stderr, "  %s serve [options]\n\n", args[0])
 		fmt.Fprintf(stderr, "Options:\n")
 		fs.PrintDefaults()
 		fmt.Fprintf(stderr, "\nExamples:\n")
@@ -43,6 +108,22 @@ func parseCLIFlags(args []string, stderr io.Writer) (*CLIConfig, error) {
 		fmt.Fprintf(stderr, "  %s -i input.json -l python -o output.py\n", args[0])
 		fmt.Fprintf(stderr, "  cat input.json | %s -l typescript\n", args[0])
 		fmt.Fprintf(stderr, "  %s -i input.json -l go -p models -r User\n", args[0])
+		fmt.Fprintf(stderr, "  %s -i openapi.yaml -f openapi -l typescript\n", args[0])
+		fmt.Fprintf(stderr, "  %s -i config.toml -l go -r Config\n", args[0])
+		fmt.Fprintf(stderr, "  %s -i rows.csv -l go -r Row -opt tags=csv\n", args[0])
+		fmt.Fprintf(stderr, "  %s -i response.json -l typescript -opt style=zod\n", args[0])
+		fmt.Fprintf(stderr, "  %s -i input.json -l go -opt codec=true\n", args[0])
+		fmt.Fprintf(stderr, "  %s -i samples.json -l jsonschema -opt validate=true\n", args[0])
+		fmt.Fprintf(stderr, "  %s -i users.json -l java -opt docs=true\n", args[0])
+		fmt.Fprintf(stderr, "  %s -i input.json -l kotlin -template templates/\n", args[0])
+		fmt.Fprintf(stderr, "  %s -i input.json -l terraform -plugins ./plugins -o out/\n", args[0])
+		fmt.Fprintf(stderr, "  %s -i input.json -l go,typescript,python -o models/ -opt typescript.style=zod\n", args[0])
+		fmt.Fprintf(stderr, "  %s -i order.json -l go -override '$.metadata=map<string,string>' -override '$.items[*]=OrderLine'\n", args[0])
+		fmt.Fprintf(stderr, "  %s -i samples/ -l go -o models.go -watch\n", args[0])
+		fmt.Fprintf(stderr, "  %s -i samples/ -l go -o models.go -check\n", args[0])
+		fmt.Fprintf(stderr, "\nvalidate=true (go, java, python pydantic, jsonschema) turns the samples into\n")
+		fmt.Fprintf(stderr, "constraints: required fields, non-empty strings and lists, and value or length\n")
+		fmt.Fprintf(stderr, "ranges once a field has at least 5 distinct sample values.\n")
 	}
 
 	if err := fs.Parse(args[1:]); err != nil {
@@ -55,42 +136,346 @@ func parseCLIFlags(args []string, stderr io.Writer) (*CLIConfig, error) {
 }
 
 func runCLI(args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	if len(args) > 1 && args[1] == "serve" {
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+		return runServe(ctx, args, stderr)
+	}
+	if len(args) > 1 && args[1] == "generate" {
+		return runGenerate(context.Background(), args, stdout, stderr)
+	}
+
 	config, err := parseCLIFlags(args, stderr)
+	if err != nil || config == nil {
+		return err
+	}
+
+	if config.Watch && config.Check {
+		return errors.New("-watch and -check cannot be combined")
+	}
+	if config.Watch {
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+		return runWatch(ctx, config, stderr, watchInterval)
+	}
+
+	return runJob(context.Background(), config, nil, stdin, stdout, stderr)
+}
+
+// runJob generates the code described by config and writes it, or checks
+// it against the existing output with -check. A non-nil cache shares parsed
+// inputs and registries with other jobs.
+func runJob(ctx context.Context, config *CLIConfig, cache *jobCache, stdin io.Reader, stdout, stderr io.Writer) error {
+	files, err := generateFiles(ctx, config, cache, stdin, stderr)
 	if err != nil {
 		return err
 	}
+	if config.Check {
+		return checkOutput(config.OutputFile, files, stdout)
+	}
+	return writeOutput(config.OutputFile, files, stdout, stderr)
+}
 
-	var jsonData []byte
+// generateFiles parses the input samples named by config, or stdin when
+// there is no input file, and generates code for them.
+func generateFiles(ctx context.Context, config *CLIConfig, cache *jobCache, stdin io.Reader, stderr io.Writer) ([]converter.GeneratedFile, error) {
+	registry, err := cache.registry(config.Template, config.PluginDir, config.Language, stderr)
+	if err != nil {
+		return nil, err
+	}
+	languages, err := resolveLanguages(config.Language, registry)
+	if err != nil {
+		return nil, err
+	}
+	classes, format, err := cache.parse(ctx, config, stdin)
+	if err != nil {
+		return nil, err
+	}
 
-	if config.InputFile != "" {
-		jsonData, err = os.ReadFile(config.InputFile)
+	// Every language is generated from the same parsed model, so that their
+	// outputs always agree.
+	var files []converter.GeneratedFile
+	owners := map[string]string{}
+	for _, language := range languages {
+		options := languageOptions(config.Options, language)
+		if language == "proto" && len(languages) == 1 {
+			lockProtoNumbers(options, config.OutputFile)
+		}
+		generated, err := converter.GenerateFromModel(ctx, classes, language,
+			converter.WithRegistry(registry), converter.WithFormat(format),
+			converter.WithOptions(options))
 		if err != nil {
-			return fmt.Errorf("error reading file: %w", err)
+			if len(languages) > 1 {
+				err = fmt.Errorf("%s: %w", language, err)
+			}
+			return nil, err
 		}
-	} else {
-		jsonData, err = io.ReadAll(stdin)
+		for _, file := range generated {
+			if owner, ok := owners[file.Name]; ok {
+				return nil, fmt.Errorf("languages %s and %s both generate %s", owner, language, file.Name)
+			}
+			owners[file.Name] = language
+		}
+		files = append(files, generated...)
+	}
+	return files, nil
+}
+
+// parseInput reads the samples named by config, or stdin when there is no
+// input file, and infers their model. It also returns the format the
+// samples were read as.
+func parseInput(ctx context.Context, config *CLIConfig, stdin io.Reader) ([]converter.ClassDefinition, converter.Format, error) {
+	paths, format, err := resolveInputs(config)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var samples [][]byte
+	if config.InputFile == "" {
+		data, err := io.ReadAll(stdin)
 		if err != nil {
-			return fmt.Errorf("error reading input: %w", err)
+			return nil, "", fmt.Errorf("error reading input: %w", err)
 		}
+		samples = append(samples, data)
 	}
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, "", fmt.Errorf("error reading file: %w", err)
+		}
+		samples = append(samples, data)
+	}
+
+	classes, err := converter.ParseSamples(ctx, samples,
+		converter.WithRootName(config.RootName),
+		converter.WithFormat(format),
+		converter.WithNaming(config.Naming),
+		converter.WithOverrides(config.Overrides),
+	)
+	return classes, format, err
+}
 
-	service := generator.NewGeneratorService()
-	output, err := service.GenerateFromJSON(jsonData, config.RootName, config.Language)
+// newRegistry returns the built-in languages plus those defined by the
+// templates at template and the plugins in pluginDir or on PATH. Plugins
+// are only run when language, a -lang value, names a language that is not
+// otherwise available or is "all".
+func newRegistry(template, pluginDir, language string, stderr io.Writer) (*converter.Registry, error) {
+	registry, err := loadTemplates(template)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	if needsPlugins(language, registry) {
+		if err := discoverPlugins(registry, pluginDir, stderr); err != nil {
+			return nil, err
+		}
+	}
+	return registry, nil
+}
+
+// loadTemplates returns the built-in languages plus those defined by the
+// templates at template, if any.
+func loadTemplates(template string) (*converter.Registry, error) {
+	registry := converter.NewRegistry()
+	if template != "" {
+		if err := registry.LoadTemplates(template); err != nil {
+			return nil, fmt.Errorf("error loading templates: %w", err)
+		}
+	}
+	return registry, nil
+}
+
+// discoverPlugins adds the plugins in pluginDir or on PATH to registry.
+// Plugins that cannot be described are reported on stderr and skipped.
+func discoverPlugins(registry *converter.Registry, pluginDir string, stderr io.Writer) error {
+	var pluginDirs []string
+	if pluginDir != "" {
+		pluginDirs = append(pluginDirs, pluginDir)
+	}
+	if err := registry.DiscoverPlugins(pluginDirs...); err != nil {
+		return fmt.Errorf("error loading plugins: %w", err)
 	}
-	if config.OutputFile != "" {
-		if err := os.WriteFile(config.OutputFile, []byte(output), 0644); err != nil {
-			return fmt.Errorf("error writing output file: %w", err)
+	for _, err := range registry.SkippedPlugins() {
+		fmt.Fprintf(stderr, "Warning: skipping %v\n", err)
+	}
+	return nil
+}
+
+func needsPlugins(language string, registry *converter.Registry) bool {
+	if language == "all" {
+		return true
+	}
+	for _, name := range strings.Split(language, ",") {
+		if name = strings.TrimSpace(name); name == "" {
+			continue
+		}
+		if _, err := registry.GetLanguage(name); err != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// resolveLanguages splits a comma separated -lang value into language
+// names, dropping repeats; "all" selects every language in registry.
+func resolveLanguages(value string, registry *converter.Registry) ([]string, error) {
+	if value == "all" {
+		languages := registry.GetSupportedLanguages()
+		sort.Strings(languages)
+		return languages, nil
+	}
+
+	var languages []string
+	seen := map[string]bool{}
+	for _, name := range strings.Split(value, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" || seen[name] {
+			continue
+		}
+		seen[name] = true
+		languages = append(languages, name)
+	}
+	if len(languages) == 0 {
+		return nil, errors.New("no target language given, use -l")
+	}
+	return languages, nil
+}
+
+// languageOptions returns the options that apply to language. A key of the
+// form "<language>.<key>" only applies to that language and takes precedence
+// over the plain key, so -opt typescript.style=zod leaves Python alone.
+func languageOptions(options converter.Options, language string) converter.Options {
+	result := converter.Options{}
+	for key, value := range options {
+		if !strings.Contains(key, ".") {
+			result[key] = value
+		}
+	}
+	for key, value := range options {
+		if name, ok := strings.CutPrefix(key, language+"."); ok {
+			result[name] = value
 		}
-		fmt.Fprintf(stderr, "Code generated successfully: %s\n", config.OutputFile)
-	} else {
-		fmt.Fprint(stdout, output)
 	}
+	return result
+}
 
+// lockProtoNumbers passes the .proto file at output, if there is one, to the
+// proto generator as its lock option, so that regenerating it keeps the
+// field numbers it already has. An explicit lock option wins.
+func lockProtoNumbers(options converter.Options, output string) {
+	if _, ok := options["lock"]; ok || output == "" {
+		return
+	}
+	if info, err := os.Stat(output); err != nil || !info.Mode().IsRegular() {
+		return
+	}
+	if existing, err := os.ReadFile(output); err == nil {
+		options["lock"] = string(existing)
+	}
+}
+
+// writeOutput prints a single generated file to stdout, or writes the files
+// under output when it is set.
+func writeOutput(output string, files []converter.GeneratedFile, stdout, stderr io.Writer) error {
+	if len(files) > 1 {
+		return writeOutputFiles(output, files, stderr)
+	}
+	if output == "" {
+		fmt.Fprint(stdout, files[0].Content)
+		return nil
+	}
+	return writeFile(output, files[0].Content, stderr)
+}
+
+// writeOutputFiles writes the files of a multi-file generator, such as a
+// plugin, or of several languages under the output directory.
+func writeOutputFiles(dir string, files []converter.GeneratedFile, stderr io.Writer) error {
+	if dir == "" {
+		return fmt.Errorf("generated %d files, use -o to choose an output directory", len(files))
+	}
+	for _, file := range files {
+		if err := writeFile(filepath.Join(dir, file.Name), file.Content, stderr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeFile writes content to path, creating its directory, unless the file
+// already holds exactly that content, so unchanged outputs keep their
+// modification time.
+func writeFile(path, content string, stderr io.Writer) error {
+	if existing, err := os.ReadFile(path); err == nil && string(existing) == content {
+		fmt.Fprintf(stderr, "Code unchanged: %s\n", path)
+		return nil
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("error creating output directory: %w", err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		return fmt.Errorf("error writing output file: %w", err)
+	}
+	fmt.Fprintf(stderr, "Code generated successfully: %s\n", path)
 	return nil
 }
 
+// resolveInputs returns the sample files named by -input and their format.
+// A directory stands for every file in it whose extension matches the
+// format; without -format, the files found must all share one format.
+func resolveInputs(config *CLIConfig) ([]string, converter.Format, error) {
+	format, err := resolveInputFormat(config)
+	if err != nil {
+		return nil, "", err
+	}
+	if config.InputFile == "" {
+		return nil, format, nil
+	}
+
+	info, err := os.Stat(config.InputFile)
+	if err != nil {
+		return nil, "", fmt.Errorf("error reading file: %w", err)
+	}
+	if !info.IsDir() {
+		return []string{config.InputFile}, format, nil
+	}
+
+	entries, err := os.ReadDir(config.InputFile)
+	if err != nil {
+		return nil, "", fmt.Errorf("error reading directory: %w", err)
+	}
+	var paths []string
+	var detected converter.Format
+	for _, entry := range entries {
+		fileFormat, ok := converter.DetectFormat(entry.Name())
+		if entry.IsDir() || !ok {
+			continue
+		}
+		if config.InputFormat != "" && fileFormat != format {
+			continue
+		}
+		if detected != "" && fileFormat != detected {
+			return nil, "", fmt.Errorf("directory %s mixes %s and %s samples, use -f to pick one", config.InputFile, detected, fileFormat)
+		}
+		detected = fileFormat
+		paths = append(paths, filepath.Join(config.InputFile, entry.Name()))
+	}
+	if len(paths) == 0 {
+		return nil, "", fmt.Errorf("no input samples found in %s", config.InputFile)
+	}
+	return paths, detected, nil
+}
+
+// resolveInputFormat picks the input format from the -format flag, or from
+// the input file extension when the flag is not given.
+func resolveInputFormat(config *CLIConfig) (converter.Format, error) {
+	if config.InputFormat != "" {
+		return converter.ParseFormat(config.InputFormat)
+	}
+	if format, ok := converter.DetectFormat(config.InputFile); ok {
+		return format, nil
+	}
+	return converter.FormatJSON, nil
+}
+
 func main() {
 	if err := runCLI(os.Args, os.Stdin, os.Stdout, os.Stderr); err != nil {
 		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
@@ -2,10 +2,15 @@ package main
 
 import (
 	"bytes"
+	"context"
 	"os"
 	"path/filepath"
+	"runtime"
 	"strings"
 	"testing"
+	"time"
+
+	"github.com/jguerreno/JSON-Converter/converter"
 )
 
 const validJSON = `{
@@ -338,3 +343,444 @@ func TestRunCLI_CustomRootName(t *testing.T) {
 		})
 	}
 }
+
+func TestRunCLI_OpenAPIInput(t *testing.T) {
+	spec := `{
+		"openapi": "3.0.0",
+		"paths": {},
+		"components": {
+			"schemas": {
+				"User": {
+					"type": "object",
+					"required": ["id"],
+					"properties": {
+						"id": {"type": "integer"},
+						"profile": {"$ref": "#/components/schemas/Profile"}
+					}
+				},
+				"Profile": {"type": "object", "properties": {"bio": {"type": "string"}}}
+			}
+		}
+	}`
+	args := []string{"cmd", "-f", "openapi", "-l", "go"}
+	stdin := strings.NewReader(spec)
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+
+	err := runCLI(args, stdin, stdout, stderr)
+	if err != nil {
+		t.Fatalf("runCLI failed: %v", err)
+	}
+
+	output := stdout.String()
+	if !strings.Contains(output, "type User struct") {
+		t.Error("expected User struct from components/schemas")
+	}
+	if !strings.Contains(output, "Profile *Profile") {
+		t.Error("expected optional Profile reference in User struct")
+	}
+}
+
+func TestRunCLI_UnsupportedInputFormat(t *testing.T) {
+	args := []string{"cmd", "-f", "ini"}
+	stdin := strings.NewReader(validJSON)
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+
+	err := runCLI(args, stdin, stdout, stderr)
+	if err == nil {
+		t.Fatal("expected error for unsupported input format")
+	}
+	if !strings.Contains(err.Error(), "not supported") {
+		t.Errorf("expected 'not supported' in error message, got: %v", err)
+	}
+}
+
+func TestParseCLIFlags_Options(t *testing.T) {
+	args := []string{"cmd", "-opt", "tags=yaml,toml", "-opt", "style=zod"}
+	stderr := &bytes.Buffer{}
+
+	config, err := parseCLIFlags(args, stderr)
+	if err != nil {
+		t.Fatalf("parseCLIFlags failed: %v", err)
+	}
+
+	if config.Options["tags"] != "yaml,toml" {
+		t.Errorf("expected tags=yaml,toml, got %q", config.Options["tags"])
+	}
+	if config.Options["style"] != "zod" {
+		t.Errorf("expected style=zod, got %q", config.Options["style"])
+	}
+}
+
+func TestRunCLI_YAMLInputDetectedFromExtension(t *testing.T) {
+	tmpDir := t.TempDir()
+	inputFile := filepath.Join(tmpDir, "config.yaml")
+	err := os.WriteFile(inputFile, []byte("name: api\nport: 8080\n"), 0644)
+	if err != nil {
+		t.Fatalf("failed to create temp file: %v", err)
+	}
+
+	args := []string{"cmd", "-i", inputFile, "-l", "go", "-r", "Config"}
+	stdin := &bytes.Buffer{}
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+
+	err = runCLI(args, stdin, stdout, stderr)
+	if err != nil {
+		t.Fatalf("runCLI failed: %v", err)
+	}
+
+	output := stdout.String()
+	if !strings.Contains(output, "type Config struct") {
+		t.Error("expected Config struct in output")
+	}
+	if !strings.Contains(output, "`json:\"port\" yaml:\"port\"`") {
+		t.Errorf("expected json and yaml tags in output, got:\n%s", output)
+	}
+}
+
+func TestRunCLI_TOMLInputFlag(t *testing.T) {
+	args := []string{"cmd", "-f", "toml", "-l", "go", "-r", "Config"}
+	stdin := strings.NewReader("title = \"svc\"\n")
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+
+	err := runCLI(args, stdin, stdout, stderr)
+	if err != nil {
+		t.Fatalf("runCLI failed: %v", err)
+	}
+
+	if !strings.Contains(stdout.String(), "`json:\"title\" toml:\"title\"`") {
+		t.Errorf("expected json and toml tags in output, got:\n%s", stdout.String())
+	}
+}
+
+func TestRunCLI_CSVInputWithCSVTags(t *testing.T) {
+	args := []string{"cmd", "-f", "csv", "-l", "go", "-r", "Row", "-opt", "tags=csv"}
+	stdin := strings.NewReader("id,name\n1,alice\n2,\n")
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+
+	err := runCLI(args, stdin, stdout, stderr)
+	if err != nil {
+		t.Fatalf("runCLI failed: %v", err)
+	}
+
+	output := stdout.String()
+	if !strings.Contains(output, "type Row struct") {
+		t.Error("expected Row struct in output")
+	}
+	if !strings.Contains(output, "Name *string `json:\"name,omitempty\" csv:\"name,omitempty\"`") {
+		t.Errorf("expected optional name column with csv tag, got:\n%s", output)
+	}
+}
+
+func TestRunCLI_TemplateLanguage(t *testing.T) {
+	dir := t.TempDir()
+	templateFile := filepath.Join(dir, "kotlin.tmpl")
+	if err := os.WriteFile(templateFile, []byte(`{{ range .Classes }}data class {{ .Name }}{{ end }}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "kotlin.json"), []byte(`{"extension": "kt"}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	args := []string{"cmd", "-l", "kotlin", "-r", "User", "-template", templateFile}
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+
+	err := runCLI(args, strings.NewReader(validJSON), stdout, stderr)
+	if err != nil {
+		t.Fatalf("runCLI failed: %v", err)
+	}
+
+	if !strings.Contains(stdout.String(), "data class User") {
+		t.Errorf("expected output from the kotlin template, got:\n%s", stdout.String())
+	}
+}
+
+func TestRunCLI_ProtoKeepsFieldNumbers(t *testing.T) {
+	dir := t.TempDir()
+	input := filepath.Join(dir, "user.json")
+	output := filepath.Join(dir, "user.proto")
+	args := []string{"cmd", "-i", input, "-o", output, "-l", "proto", "-r", "User"}
+
+	for _, sample := range []string{`{"a": 1, "b": 2}`, `{"b": 2, "c": 3}`} {
+		if err := os.WriteFile(input, []byte(sample), 0644); err != nil {
+			t.Fatal(err)
+		}
+		if err := runCLI(args, strings.NewReader(""), &bytes.Buffer{}, &bytes.Buffer{}); err != nil {
+			t.Fatalf("runCLI failed: %v", err)
+		}
+	}
+
+	content, err := os.ReadFile(output)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"int64 b = 2;", "int64 c = 3;", "reserved 1;", `reserved "a";`} {
+		if !strings.Contains(string(content), want) {
+			t.Errorf("expected %q in regenerated proto:\n%s", want, content)
+		}
+	}
+}
+
+func TestRunCLI_BrokenPlugin(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("plugin script needs a Unix shell")
+	}
+	dir := t.TempDir()
+	script := "#!/bin/sh\necho started >> " + filepath.Join(dir, "runs") + "\nexit 1\n"
+	if err := os.WriteFile(filepath.Join(dir, "json-converter-gen-broken"), []byte(script), 0755); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("PATH", dir)
+
+	// A built-in language never runs plugins.
+	err := runCLI([]string{"cmd", "-l", "go"}, strings.NewReader(validJSON), &bytes.Buffer{}, &bytes.Buffer{})
+	if err != nil {
+		t.Fatalf("runCLI failed: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "runs")); err == nil {
+		t.Error("expected plugins not to run for a built-in language")
+	}
+
+	// Other languages are still looked up, with a warning for the broken plugin.
+	stderr := &bytes.Buffer{}
+	err = runCLI([]string{"cmd", "-l", "broken"}, strings.NewReader(validJSON), &bytes.Buffer{}, stderr)
+	if err == nil || !strings.Contains(err.Error(), "not supported") {
+		t.Errorf("expected 'not supported' error, got: %v", err)
+	}
+	if !strings.Contains(stderr.String(), "Warning: skipping plugin json-converter-gen-broken") {
+		t.Errorf("expected a warning for the broken plugin, got:\n%s", stderr.String())
+	}
+}
+
+func TestRunServeRunsPluginsOnlyWhenAsked(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("plugin script needs a Unix shell")
+	}
+	dir := t.TempDir()
+	script := "#!/bin/sh\necho started >> " + filepath.Join(dir, "runs") + "\nexit 1\n"
+	if err := os.WriteFile(filepath.Join(dir, "json-converter-gen-broken"), []byte(script), 0755); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("PATH", dir)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := runServe(ctx, []string{"cmd", "serve", "-addr", "127.0.0.1:0"}, &bytes.Buffer{}); err != nil {
+		t.Fatalf("runServe failed: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "runs")); err == nil {
+		t.Error("expected serve not to run plugins without -plugins")
+	}
+
+	stderr := &bytes.Buffer{}
+	if err := runServe(ctx, []string{"cmd", "serve", "-addr", "127.0.0.1:0", "-plugins", dir}, stderr); err != nil {
+		t.Fatalf("runServe failed: %v", err)
+	}
+	if !strings.Contains(stderr.String(), "Warning: skipping plugin json-converter-gen-broken") {
+		t.Errorf("expected -plugins to run the plugins, got:\n%s", stderr.String())
+	}
+}
+
+func TestWriteOutputFiles(t *testing.T) {
+	dir := t.TempDir()
+	files := []converter.GeneratedFile{
+		{Name: "variables.tf", Content: "variable \"id\" {}\n"},
+		{Name: "modules/server.tf", Content: "module \"server\" {}\n"},
+	}
+	stderr := &bytes.Buffer{}
+
+	if err := writeOutputFiles(dir, files, stderr); err != nil {
+		t.Fatalf("writeOutputFiles failed: %v", err)
+	}
+	for _, file := range files {
+		content, err := os.ReadFile(filepath.Join(dir, file.Name))
+		if err != nil || string(content) != file.Content {
+			t.Errorf("expected %s to contain %q, got %q (%v)", file.Name, file.Content, content, err)
+		}
+	}
+
+	if err := writeOutputFiles("", files, stderr); err == nil || !strings.Contains(err.Error(), "use -o") {
+		t.Errorf("expected an error asking for an output directory, got %v", err)
+	}
+}
+
+func TestParseServeFlags(t *testing.T) {
+	args := []string{"cmd", "serve", "-addr", ":9000", "-max-body", "2048", "-timeout", "3s"}
+
+	config, err := parseServeFlags(args, &bytes.Buffer{})
+	if err != nil {
+		t.Fatalf("parseServeFlags failed: %v", err)
+	}
+	if config.Addr != ":9000" || config.MaxBodyBytes != 2048 || config.Timeout != 3*time.Second {
+		t.Errorf("unexpected serve config: %+v", config)
+	}
+}
+
+func TestRunCLI_Help(t *testing.T) {
+	stderr := &bytes.Buffer{}
+	if err := runCLI([]string{"cmd", "-h"}, strings.NewReader(""), &bytes.Buffer{}, stderr); err != nil {
+		t.Fatalf("runCLI -h failed: %v", err)
+	}
+	if !strings.Contains(stderr.String(), "Usage:") {
+		t.Error("expected usage in stderr")
+	}
+}
+
+func TestRunCLI_DirectoryInput(t *testing.T) {
+	dir := t.TempDir()
+	samples := map[string]string{
+		"a.json":    `{"id": 1, "name": "web"}`,
+		"b.json":    `{"id": 2, "name": "worker", "port": 8080}`,
+		"notes.txt": "not a sample",
+	}
+	for name, content := range samples {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	stdout := &bytes.Buffer{}
+	err := runCLI([]string{"cmd", "-i", dir, "-l", "go", "-r", "Service"}, strings.NewReader(""), stdout, &bytes.Buffer{})
+	if err != nil {
+		t.Fatalf("runCLI failed: %v", err)
+	}
+	if !strings.Contains(stdout.String(), "Port *int") {
+		t.Errorf("expected port to be optional across samples, got:\n%s", stdout.String())
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, "c.yaml"), []byte("id: 3\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	err = runCLI([]string{"cmd", "-i", dir}, strings.NewReader(""), &bytes.Buffer{}, &bytes.Buffer{})
+	if err == nil || !strings.Contains(err.Error(), "mixes json and yaml") {
+		t.Errorf("expected an error for mixed formats, got %v", err)
+	}
+}
+
+func TestWriteFileSkipsUnchanged(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "models.go")
+	stderr := &bytes.Buffer{}
+
+	if err := writeFile(path, "package models\n", stderr); err != nil {
+		t.Fatalf("writeFile failed: %v", err)
+	}
+	if err := writeFile(path, "package models\n", stderr); err != nil {
+		t.Fatalf("writeFile failed: %v", err)
+	}
+	if !strings.Contains(stderr.String(), "Code generated successfully: "+path) || !strings.Contains(stderr.String(), "Code unchanged: "+path) {
+		t.Errorf("unexpected messages:\n%s", stderr.String())
+	}
+}
+
+func TestRunCLI_MultipleLanguages(t *testing.T) {
+	dir := t.TempDir()
+	args := []string{"cmd", "-l", "go,typescript,python", "-o", dir, "-r", "User", "-opt", "typescript.style=zod"}
+
+	err := runCLI(args, strings.NewReader(validJSON), &bytes.Buffer{}, &bytes.Buffer{})
+	if err != nil {
+		t.Fatalf("runCLI failed: %v", err)
+	}
+
+	expected := map[string]string{
+		"models.go": "type User struct",
+		"models.ts": "export const UserSchema = z.object({",
+		"models.py": "class User:",
+	}
+	for name, want := range expected {
+		content, err := os.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			t.Fatalf("expected %s to be written: %v", name, err)
+		}
+		if !strings.Contains(string(content), want) {
+			t.Errorf("expected %q in %s, got:\n%s", want, name, content)
+		}
+	}
+
+	err = runCLI([]string{"cmd", "-l", "go,python"}, strings.NewReader(validJSON), &bytes.Buffer{}, &bytes.Buffer{})
+	if err == nil || !strings.Contains(err.Error(), "use -o") {
+		t.Errorf("expected an error asking for an output directory, got %v", err)
+	}
+	err = runCLI([]string{"cmd", "-l", "go,cobol", "-o", dir}, strings.NewReader(validJSON), &bytes.Buffer{}, &bytes.Buffer{})
+	if err == nil || !strings.Contains(err.Error(), "cobol: language 'cobol' not supported") {
+		t.Errorf("expected the failing language to be named, got %v", err)
+	}
+}
+
+func TestResolveLanguages(t *testing.T) {
+	registry := converter.NewRegistry()
+
+	languages, err := resolveLanguages(" go, typescript,go,", registry)
+	if err != nil || strings.Join(languages, ",") != "go,typescript" {
+		t.Errorf("resolveLanguages() = %v, %v", languages, err)
+	}
+	if all, err := resolveLanguages("all", registry); err != nil || len(all) != len(registry.GetSupportedLanguages()) {
+		t.Errorf("expected all registered languages, got %v, %v", all, err)
+	}
+	if _, err := resolveLanguages(",", registry); err == nil {
+		t.Error("expected an error for an empty language list")
+	}
+}
+
+func TestLanguageOptions(t *testing.T) {
+	options := converter.Options{"docs": "true", "style": "dataclass", "typescript.style": "zod", "go.package": "api"}
+
+	tests := []struct {
+		language string
+		want     converter.Options
+	}{
+		{"typescript", converter.Options{"docs": "true", "style": "zod"}},
+		{"python", converter.Options{"docs": "true", "style": "dataclass"}},
+		{"go", converter.Options{"docs": "true", "style": "dataclass", "package": "api"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.language, func(t *testing.T) {
+			got := languageOptions(options, tt.language)
+			if len(got) != len(tt.want) {
+				t.Fatalf("languageOptions() = %v, want %v", got, tt.want)
+			}
+			for key, value := range tt.want {
+				if got[key] != value {
+					t.Errorf("languageOptions()[%q] = %q, want %q", key, got[key], value)
+				}
+			}
+		})
+	}
+}
+
+func TestParseCLIFlags_Overrides(t *testing.T) {
+	args := []string{"cmd", "-override", "$.created_at=datetime", "-override", `$["a=b"]=string`}
+
+	config, err := parseCLIFlags(args, &bytes.Buffer{})
+	if err != nil {
+		t.Fatalf("parseCLIFlags failed: %v", err)
+	}
+	if config.Overrides["$.created_at"] != "datetime" || config.Overrides[`$["a=b"]`] != "string" {
+		t.Errorf("unexpected overrides: %v", config.Overrides)
+	}
+
+	if _, err := parseCLIFlags([]string{"cmd", "-override", "datetime"}, &bytes.Buffer{}); err == nil {
+		t.Error("expected an error for an override without a path")
+	}
+}
+
+func TestRunCLI_Overrides(t *testing.T) {
+	args := []string{"cmd", "-l", "go", "-override", "$.profile=map<string,string>", "-override", "$.roles=skip"}
+	stdout := &bytes.Buffer{}
+
+	if err := runCLI(args, strings.NewReader(validJSON), stdout, &bytes.Buffer{}); err != nil {
+		t.Fatalf("runCLI failed: %v", err)
+	}
+	output := stdout.String()
+	if !strings.Contains(output, "Profile map[string]string") {
+		t.Errorf("expected profile to be a map, got:\n%s", output)
+	}
+	if strings.Contains(output, "Roles") || strings.Contains(output, "type Profile struct") {
+		t.Errorf("expected roles and the profile class to be gone, got:\n%s", output)
+	}
+}
@@ -0,0 +1,207 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/jguerreno/JSON-Converter/converter"
+)
+
+// projectFileNames are the config files the generate command looks for in
+// the working directory when -config is not given.
+var projectFileNames = []string{"json-converter.yaml", "json-converter.yml", "json-converter.json"}
+
+// ProjectConfig describes a batch of generation jobs, read from a
+// json-converter.yaml (or .json) file:
+//
+//	jobs:
+//	  - name: users
+//	    input: samples/users/
+//	    root: User
+//	    naming:
+//	      prefix: Api
+//	    types:
+//	      ProfileItem: Profile
+//	    overrides:
+//	      $.created_at: datetime
+//	      $.metadata: map<string,string>
+//	      $.legacy_field: skip
+//	    options:
+//	      docs: "true"
+//	    targets:
+//	      - language: go
+//	        output: internal/models/user.go
+//	        options:
+//	          validate: "true"
+//	      - language: typescript
+//	        output: web/src/models/user.ts
+//
+// Relative paths are resolved against the directory of the config file.
+type ProjectConfig struct {
+	Jobs []Job `yaml:"jobs" json:"jobs"`
+}
+
+// Job turns one input file or directory into code for one or more targets.
+// Its fields mirror the flags of a single CLI run.
+type Job struct {
+	Name      string            `yaml:"name" json:"name"`
+	Input     string            `yaml:"input" json:"input"`
+	Format    string            `yaml:"format" json:"format"`
+	Root      string            `yaml:"root" json:"root"`
+	Template  string            `yaml:"template" json:"template"`
+	Plugins   string            `yaml:"plugins" json:"plugins"`
+	Naming    NamingConfig      `yaml:"naming" json:"naming"`
+	Types     map[string]string `yaml:"types" json:"types"`
+	Overrides map[string]string `yaml:"overrides" json:"overrides"`
+	Options   converter.Options `yaml:"options" json:"options"`
+	Targets   []Target          `yaml:"targets" json:"targets"`
+}
+
+// NamingConfig adds a prefix and a suffix to every inferred class name,
+// except those given an explicit name in Job.Types.
+type NamingConfig struct {
+	Prefix string `yaml:"prefix" json:"prefix"`
+	Suffix string `yaml:"suffix" json:"suffix"`
+}
+
+// Target is one output of a job. Like -lang, Language may list several
+// languages or be "all", and Output is then a directory. Its options are
+// added to the job's, replacing those with the same key.
+type Target struct {
+	Language string            `yaml:"language" json:"language"`
+	Output   string            `yaml:"output" json:"output"`
+	Options  converter.Options `yaml:"options" json:"options"`
+}
+
+// findProjectConfig returns the first of projectFileNames found in dir.
+func findProjectConfig(dir string) (string, error) {
+	for _, name := range projectFileNames {
+		path := filepath.Join(dir, name)
+		if _, err := os.Stat(path); err == nil {
+			return path, nil
+		} else if !errors.Is(err, fs.ErrNotExist) {
+			return "", err
+		}
+	}
+	return "", fmt.Errorf("no %s found, use -config", projectFileNames[0])
+}
+
+// loadProjectConfig reads and validates a project config, resolving its
+// paths against the directory of the file.
+func loadProjectConfig(path string) (*ProjectConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading config: %w", err)
+	}
+
+	var project ProjectConfig
+	if filepath.Ext(path) == ".json" {
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.DisallowUnknownFields()
+		err = decoder.Decode(&project)
+	} else {
+		decoder := yaml.NewDecoder(bytes.NewReader(data))
+		decoder.KnownFields(true)
+		err = decoder.Decode(&project)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid config %s: %w", path, err)
+	}
+
+	dir := filepath.Dir(path)
+	if err := project.resolve(dir); err != nil {
+		return nil, fmt.Errorf("invalid config %s: %w", path, err)
+	}
+	return &project, nil
+}
+
+// resolve fills in defaults, makes paths relative to dir and checks that
+// every job can run.
+func (p *ProjectConfig) resolve(dir string) error {
+	if len(p.Jobs) == 0 {
+		return errors.New("no jobs defined")
+	}
+
+	names := map[string]bool{}
+	outputs := map[string]string{}
+	for i := range p.Jobs {
+		job := &p.Jobs[i]
+		if job.Input == "" {
+			return fmt.Errorf("job %d has no input", i+1)
+		}
+		if job.Name == "" {
+			job.Name = job.Input
+		}
+		if names[job.Name] {
+			return fmt.Errorf("job name '%s' is used more than once", job.Name)
+		}
+		names[job.Name] = true
+		if job.Root == "" {
+			job.Root = "Root"
+		}
+		if len(job.Targets) == 0 {
+			return fmt.Errorf("job '%s' has no targets", job.Name)
+		}
+
+		job.Input = resolvePath(dir, job.Input)
+		job.Template = resolvePath(dir, job.Template)
+		job.Plugins = resolvePath(dir, job.Plugins)
+		for j := range job.Targets {
+			target := &job.Targets[j]
+			if target.Language == "" {
+				return fmt.Errorf("target %d of job '%s' has no language", j+1, job.Name)
+			}
+			if target.Output == "" {
+				return fmt.Errorf("target '%s' of job '%s' has no output", target.Language, job.Name)
+			}
+			target.Output = resolvePath(dir, target.Output)
+			if other, ok := outputs[target.Output]; ok {
+				return fmt.Errorf("output %s is written by both job '%s' and job '%s'", target.Output, other, job.Name)
+			}
+			outputs[target.Output] = job.Name
+		}
+	}
+	return nil
+}
+
+func resolvePath(dir, path string) string {
+	if path == "" || filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(dir, path)
+}
+
+// cliConfig returns the single CLI run that generates target.
+func (j Job) cliConfig(target Target) *CLIConfig {
+	options := converter.Options{}
+	for key, value := range j.Options {
+		options[key] = value
+	}
+	for key, value := range target.Options {
+		options[key] = value
+	}
+
+	return &CLIConfig{
+		InputFile:   j.Input,
+		OutputFile:  target.Output,
+		Language:    target.Language,
+		RootName:    j.Root,
+		InputFormat: j.Format,
+		Template:    j.Template,
+		PluginDir:   j.Plugins,
+		Naming: converter.Naming{
+			Prefix: j.Naming.Prefix,
+			Suffix: j.Naming.Suffix,
+			Types:  j.Types,
+		},
+		Overrides: j.Overrides,
+		Options:   options,
+	}
+}
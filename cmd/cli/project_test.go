@@ -0,0 +1,121 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func writeProjectFile(t *testing.T, dir, name, content string) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoadProjectConfig(t *testing.T) {
+	dir := t.TempDir()
+	path := writeProjectFile(t, dir, "json-converter.yaml", `
+jobs:
+  - input: samples/user.json
+    root: User
+    naming:
+      prefix: Api
+    types:
+      Profile: UserProfile
+    overrides:
+      $.created_at: datetime
+    options:
+      docs: true
+      package: shared
+    targets:
+      - language: go
+        output: gen/user.go
+        options:
+          package: models
+`)
+
+	project, err := loadProjectConfig(path)
+	if err != nil {
+		t.Fatalf("loadProjectConfig failed: %v", err)
+	}
+	job := project.Jobs[0]
+	if job.Name != "samples/user.json" {
+		t.Errorf("expected the job to be named after its input, got %q", job.Name)
+	}
+
+	config := job.cliConfig(job.Targets[0])
+	if config.InputFile != filepath.Join(dir, "samples/user.json") || config.OutputFile != filepath.Join(dir, "gen/user.go") {
+		t.Errorf("expected paths relative to the config file, got %q and %q", config.InputFile, config.OutputFile)
+	}
+	if config.RootName != "User" || config.Language != "go" {
+		t.Errorf("unexpected config: %+v", config)
+	}
+	if config.Options["docs"] != "true" || config.Options["package"] != "models" {
+		t.Errorf("expected target options to override job options, got %v", config.Options)
+	}
+	if config.Naming.Prefix != "Api" || config.Naming.Types["Profile"] != "UserProfile" {
+		t.Errorf("unexpected naming: %+v", config.Naming)
+	}
+	if config.Overrides["$.created_at"] != "datetime" {
+		t.Errorf("unexpected overrides: %v", config.Overrides)
+	}
+}
+
+func TestLoadProjectConfigJSON(t *testing.T) {
+	dir := t.TempDir()
+	path := writeProjectFile(t, dir, "json-converter.json",
+		`{"jobs": [{"name": "users", "input": "/data/users", "targets": [{"language": "python", "output": "users.py"}]}]}`)
+
+	project, err := loadProjectConfig(path)
+	if err != nil {
+		t.Fatalf("loadProjectConfig failed: %v", err)
+	}
+	job := project.Jobs[0]
+	if job.Input != "/data/users" || job.Root != "Root" {
+		t.Errorf("expected an absolute input to be kept and the default root, got %+v", job)
+	}
+}
+
+func TestLoadProjectConfigErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  string
+		wantErr string
+	}{
+		{"no jobs", "jobs: []\n", "no jobs defined"},
+		{"no input", "jobs:\n  - targets: [{language: go, output: a.go}]\n", "job 1 has no input"},
+		{"no targets", "jobs:\n  - input: a.json\n", "has no targets"},
+		{"no output", "jobs:\n  - input: a.json\n    targets: [{language: go}]\n", "has no output"},
+		{"shared output", "jobs:\n  - {input: a.json, targets: [{language: go, output: a.go}]}\n  - {input: b.json, targets: [{language: go, output: a.go}]}\n", "is written by both"},
+		{"unknown field", "jobs:\n  - input: a.json\n    language: go\n", "field language not found"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := writeProjectFile(t, t.TempDir(), "json-converter.yaml", tt.config)
+			_, err := loadProjectConfig(path)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("loadProjectConfig() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestFindProjectConfig(t *testing.T) {
+	dir := t.TempDir()
+	if _, err := findProjectConfig(dir); err == nil || !strings.Contains(err.Error(), "use -config") {
+		t.Errorf("expected an error asking for -config, got %v", err)
+	}
+
+	path := writeProjectFile(t, dir, "json-converter.json", "{}")
+	if found, err := findProjectConfig(dir); err != nil || found != path {
+		t.Errorf("findProjectConfig() = %q, %v, want %q", found, err, path)
+	}
+}
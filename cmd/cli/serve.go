@@ -0,0 +1,105 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/jguerreno/JSON-Converter/internal/server"
+)
+
+type ServeConfig struct {
+	Addr          string
+	MaxBodyBytes  int64
+	Timeout       time.Duration
+	MaxConcurrent int
+	Template      string
+	PluginDir     string
+}
+
+func parseServeFlags(args []string, stderr io.Writer) (*ServeConfig, error) {
+	config := &ServeConfig{}
+
+	fs := flag.NewFlagSet(args[0]+" serve", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+
+	fs.StringVar(&config.Addr, "addr", "localhost:8080", "Address to listen on")
+	fs.Int64Var(&config.MaxBodyBytes, "max-body", server.DefaultMaxBodyBytes, "Maximum size of a posted document in bytes")
+	fs.DurationVar(&config.Timeout, "timeout", server.DefaultTimeout, "Maximum time spent generating code for one request")
+	fs.IntVar(&config.MaxConcurrent, "max-concurrent", 0, "Maximum number of requests generating at once (default: number of CPUs)")
+	fs.StringVar(&config.Template, "template", "", "Template file or directory overriding a language or adding a new one")
+	fs.StringVar(&config.PluginDir, "plugins", "", "Serve json-converter-gen-<lang> plugins, searched for in this directory and then PATH (default: no plugins)")
+
+	fs.Usage = func() {
+		fmt.Fprintf(stderr, "Serve the generator over HTTP\n\n")
+		fmt.Fprintf(stderr, "Usage:\n")
+		fmt.Fprintf(stderr, "  %s serve [options]\n\n", args[0])
+		fmt.Fprintf(stderr, "Options:\n")
+		fs.PrintDefaults()
+		fmt.Fprintf(stderr, "\nEndpoints:\n")
+		fmt.Fprintf(stderr, "  GET  /                  browser playground\n")
+		fmt.Fprintf(stderr, "  GET  /api/languages\n")
+		fmt.Fprintf(stderr, "  POST /api/generate?language=go&root=User&format=json&opt=key=value\n")
+	}
+
+	if err := fs.Parse(args[2:]); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to parse flags: %w", err)
+	}
+	return config, nil
+}
+
+// runServe serves the generator until ctx is cancelled, then shuts down
+// gracefully.
+func runServe(ctx context.Context, args []string, stderr io.Writer) error {
+	config, err := parseServeFlags(args, stderr)
+	if err != nil || config == nil {
+		return err
+	}
+
+	// Plugins run on behalf of every client, so they are only served when
+	// asked for.
+	registry, err := loadTemplates(config.Template)
+	if err != nil {
+		return err
+	}
+	if config.PluginDir != "" {
+		if err := discoverPlugins(registry, config.PluginDir, stderr); err != nil {
+			return err
+		}
+	}
+
+	httpServer := &http.Server{
+		Addr: config.Addr,
+		Handler: server.NewServer(server.Config{
+			Registry:      registry,
+			MaxBodyBytes:  config.MaxBodyBytes,
+			Timeout:       config.Timeout,
+			MaxConcurrent: config.MaxConcurrent,
+		}),
+		ReadHeaderTimeout: 5 * time.Second,
+		ReadTimeout:       30 * time.Second,
+		WriteTimeout:      config.Timeout + 30*time.Second,
+	}
+
+	errs := make(chan error, 1)
+	go func() {
+		errs <- httpServer.ListenAndServe()
+	}()
+	fmt.Fprintf(stderr, "Serving on http://%s\n", config.Addr)
+
+	select {
+	case err := <-errs:
+		return err
+	case <-ctx.Done():
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		return httpServer.Shutdown(shutdownCtx)
+	}
+}
@@ -0,0 +1,70 @@
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+)
+
+// watchInterval is how often -watch polls the inputs. Polling works the same
+// on every platform and file system, network mounts included.
+const watchInterval = 500 * time.Millisecond
+
+// runWatch regenerates the output whenever the inputs change, until ctx is
+// cancelled. Errors are reported and the previous output is left in place,
+// so a sample that is saved half-edited does not end the session.
+func runWatch(ctx context.Context, config *CLIConfig, stderr io.Writer, interval time.Duration) error {
+	if config.InputFile == "" {
+		return errors.New("-watch needs an input file or directory, use -i")
+	}
+	if config.OutputFile == "" {
+		return errors.New("-watch needs an output file or directory, use -o")
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	fmt.Fprintf(stderr, "Watching %s for changes\n", config.InputFile)
+	last := ""
+	for {
+		if current := inputFingerprint(config); current != last {
+			last = current
+			if err := runJob(ctx, config, nil, nil, io.Discard, stderr); err != nil {
+				fmt.Fprintf(stderr, "Error: %v\n", err)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// inputFingerprint summarizes the name and content of every input. Content
+// is hashed rather than trusting the modification time, which file systems
+// with a coarse clock leave unchanged by a quick edit of the same size. A
+// failure to list or read the inputs yields its error message, so the same
+// failure is only reported once.
+func inputFingerprint(config *CLIConfig) string {
+	paths, _, err := resolveInputs(config)
+	if err != nil {
+		return err.Error()
+	}
+
+	var fingerprint strings.Builder
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err.Error()
+		}
+		fmt.Fprintf(&fingerprint, "%s|%x\n", path, sha256.Sum256(data))
+	}
+	return fingerprint.String()
+}
@@ -0,0 +1,129 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// syncBuffer lets the test read what the watch loop writes concurrently.
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+func waitFor(t *testing.T, what string, condition func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for !condition() {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for %s", what)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func TestRunWatch(t *testing.T) {
+	dir := t.TempDir()
+	input := filepath.Join(dir, "user.json")
+	output := filepath.Join(dir, "user.go")
+	if err := os.WriteFile(input, []byte(`{"name": "alice"}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	config := &CLIConfig{InputFile: input, OutputFile: output, Language: "go", RootName: "User"}
+	stderr := &syncBuffer{}
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- runWatch(ctx, config, stderr, 10*time.Millisecond)
+	}()
+
+	outputContains := func(want string) func() bool {
+		return func() bool {
+			content, err := os.ReadFile(output)
+			return err == nil && strings.Contains(string(content), want)
+		}
+	}
+	waitFor(t, "initial output", outputContains("Name string"))
+
+	if err := os.WriteFile(input, []byte(`{"name": `), 0644); err != nil {
+		t.Fatal(err)
+	}
+	waitFor(t, "parse error", func() bool { return strings.Contains(stderr.String(), "Error: error parsing JSON") })
+
+	if err := os.WriteFile(input, []byte(`{"name": "alice", "age": 30}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	waitFor(t, "regenerated output", outputContains("Age int"))
+
+	cancel()
+	if err := <-done; err != nil {
+		t.Errorf("runWatch returned %v", err)
+	}
+	if got := strings.Count(stderr.String(), "Code generated successfully"); got != 2 {
+		t.Errorf("expected 2 writes, got %d:\n%s", got, stderr.String())
+	}
+}
+
+func TestInputFingerprintHashesContent(t *testing.T) {
+	dir := t.TempDir()
+	input := filepath.Join(dir, "user.json")
+	if err := os.WriteFile(input, []byte(`{"id": 1}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	info, err := os.Stat(input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	config := &CLIConfig{InputFile: input}
+	before := inputFingerprint(config)
+
+	// An edit of the same size within the file system's clock resolution.
+	if err := os.WriteFile(input, []byte(`{"id": 2}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chtimes(input, info.ModTime(), info.ModTime()); err != nil {
+		t.Fatal(err)
+	}
+	if inputFingerprint(config) == before {
+		t.Error("expected a same-size edit with an unchanged modification time to change the fingerprint")
+	}
+}
+
+func TestRunWatchRequiresPaths(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  CLIConfig
+		wantErr string
+	}{
+		{"no input", CLIConfig{OutputFile: "out.go"}, "use -i"},
+		{"no output", CLIConfig{InputFile: "in.json"}, "use -o"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := runWatch(context.Background(), &tt.config, &bytes.Buffer{}, time.Millisecond)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("runWatch() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"context"
+
+	"github.com/jguerreno/JSON-Converter/converter"
+)
+
+// Error codes reported to JavaScript in Error.Code.
+const (
+	ErrInvalidFormat    = "invalid_format"
+	ErrInvalidInput     = "invalid_input"
+	ErrInvalidOption    = "invalid_option"
+	ErrUnknownLanguage  = "unknown_language"
+	ErrGenerationFailed = "generation_failed"
+)
+
+// Error is the structured error handed to JavaScript instead of a thrown
+// exception, so callers can branch on Code.
+type Error struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// Result is what generate returns: the generated files and their contents
+// joined in Code, or an Error.
+type Result struct {
+	Code  string                    `json:"code,omitempty"`
+	Files []converter.GeneratedFile `json:"files,omitempty"`
+	Error *Error                    `json:"error,omitempty"`
+}
+
+// generate converts a sample document without touching the filesystem or
+// starting processes, which the browser does not allow. The options "root"
+// and "format" select the root class name and input format; every other
+// option is passed to the generator.
+func generate(ctx context.Context, registry *converter.Registry, input, language string, options map[string]string) Result {
+	if _, err := registry.GetLanguage(language); err != nil {
+		return Result{Error: &Error{Code: ErrUnknownLanguage, Message: err.Error()}}
+	}
+
+	rootName, formatName := "Root", "json"
+	generatorOptions := converter.Options{}
+	for key, value := range options {
+		switch key {
+		case "root":
+			rootName = value
+		case "format":
+			formatName = value
+		default:
+			generatorOptions[key] = value
+		}
+	}
+	format, err := converter.ParseFormat(formatName)
+	if err != nil {
+		return Result{Error: &Error{Code: ErrInvalidFormat, Message: err.Error()}}
+	}
+
+	opts := []converter.Option{
+		converter.WithRegistry(registry),
+		converter.WithRootName(rootName),
+		converter.WithFormat(format),
+		converter.WithOptions(generatorOptions),
+	}
+	classes, err := converter.Parse(ctx, []byte(input), opts...)
+	if err != nil {
+		return Result{Error: &Error{Code: ErrInvalidInput, Message: err.Error()}}
+	}
+	files, err := converter.GenerateFromModel(ctx, classes, language, opts...)
+	if err != nil {
+		return Result{Error: &Error{Code: ErrGenerationFailed, Message: err.Error()}}
+	}
+
+	result := Result{Files: files}
+	for _, file := range files {
+		result.Code += file.Content
+	}
+	return result
+}
@@ -0,0 +1,46 @@
+package main
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/jguerreno/JSON-Converter/converter"
+)
+
+func TestGenerate(t *testing.T) {
+	result := generate(context.Background(), converter.NewRegistry(), `{"id": 1}`, "typescript", map[string]string{"root": "User", "style": "zod"})
+	if result.Error != nil {
+		t.Fatalf("generate() error = %+v", result.Error)
+	}
+	if !strings.Contains(result.Code, "export const UserSchema = z.object({") {
+		t.Errorf("unexpected code:\n%s", result.Code)
+	}
+	if len(result.Files) != 1 || result.Files[0].Name != "models.ts" {
+		t.Errorf("unexpected files: %+v", result.Files)
+	}
+}
+
+func TestGenerateErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		language string
+		options  map[string]string
+		wantCode string
+	}{
+		{"unknown language", `{}`, "cobol", nil, ErrUnknownLanguage},
+		{"invalid format", `{}`, "go", map[string]string{"format": "ini"}, ErrInvalidFormat},
+		{"invalid input", `{"id":`, "go", nil, ErrInvalidInput},
+		{"invalid option", `{}`, "java", map[string]string{"style": "bean"}, ErrGenerationFailed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := generate(context.Background(), converter.NewRegistry(), tt.input, tt.language, tt.options)
+			if result.Error == nil || result.Error.Code != tt.wantCode || result.Error.Message == "" {
+				t.Errorf("generate() error = %+v, want code %q", result.Error, tt.wantCode)
+			}
+		})
+	}
+}
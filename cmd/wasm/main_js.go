@@ -0,0 +1,78 @@
+//go:build js && wasm
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"syscall/js"
+
+	"github.com/jguerreno/JSON-Converter/converter"
+)
+
+// main exposes the converter to JavaScript as
+//
+//	jsonConverter.generate(input, language, options) -> {code, files} | {error}
+//	jsonConverter.languages() -> string[]
+//	jsonConverter.version
+//
+// and then blocks so the functions stay callable.
+func main() {
+	registry := converter.NewRegistry()
+
+	js.Global().Set("jsonConverter", js.ValueOf(map[string]interface{}{
+		"generate": js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+			if len(args) < 2 {
+				return toJS(Result{Error: &Error{Code: ErrInvalidInput, Message: "generate(input, language, options) needs an input and a language"}})
+			}
+			options := map[string]string{}
+			if len(args) > 2 && args[2].Type() == js.TypeObject {
+				keys := js.Global().Get("Object").Call("keys", args[2])
+				for i := 0; i < keys.Length(); i++ {
+					key := keys.Index(i).String()
+					value, err := optionString(key, args[2].Get(key))
+					if err != nil {
+						return toJS(Result{Error: &Error{Code: ErrInvalidOption, Message: err.Error()}})
+					}
+					options[key] = value
+				}
+			}
+			return toJS(generate(context.Background(), registry, args[0].String(), args[1].String(), options))
+		}),
+		"languages": js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+			languages, _ := converter.Languages(converter.WithRegistry(registry))
+			values := make([]interface{}, len(languages))
+			for i, language := range languages {
+				values[i] = language
+			}
+			return js.ValueOf(values)
+		}),
+		"version": converter.Version,
+	}))
+
+	select {}
+}
+
+// optionString formats an option value the way it would be written on the
+// command line, so {docs: true} means the same as docs=true.
+func optionString(key string, value js.Value) (string, error) {
+	switch value.Type() {
+	case js.TypeString:
+		return value.String(), nil
+	case js.TypeBoolean:
+		return strconv.FormatBool(value.Bool()), nil
+	case js.TypeNumber:
+		return strconv.FormatFloat(value.Float(), 'f', -1, 64), nil
+	default:
+		return "", fmt.Errorf("option %q must be a string, boolean or number, got %s", key, value.Type())
+	}
+}
+
+// toJS converts a Result to a plain JavaScript object through JSON, which
+// keeps the field names identical to the json tags.
+func toJS(result Result) js.Value {
+	data, _ := json.Marshal(result)
+	return js.Global().Get("JSON").Call("parse", string(data))
+}
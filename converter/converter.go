@@ -0,0 +1,206 @@
+package converter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/jguerreno/JSON-Converter/internal/generator"
+	"github.com/jguerreno/JSON-Converter/internal/models"
+	"github.com/jguerreno/JSON-Converter/internal/parser"
+)
+
+// Version is the version of this API; see the package documentation for
+// what it guarantees.
+const Version = "1.0.0"
+
+type (
+	// ClassDefinition is a class inferred from the input.
+	ClassDefinition = models.ClassDefinition
+	// FieldDefinition is a field of a ClassDefinition.
+	FieldDefinition = models.FieldDefinition
+	// Constraints are value bounds observed for a field.
+	Constraints = models.Constraints
+	// Options are language specific generator options, such as "style".
+	Options = models.Options
+	// GeneratedFile is one file of generated code.
+	GeneratedFile = generator.GeneratedFile
+	// LanguageGenerator is implemented by every language in a Registry.
+	LanguageGenerator = generator.LanguageGenerator
+	// Registry maps language names to generators.
+	Registry = generator.GeneratorRegistry
+	// Format identifies the encoding of an input document.
+	Format = parser.Format
+)
+
+const (
+	FormatJSON    = parser.FormatJSON
+	FormatYAML    = parser.FormatYAML
+	FormatTOML    = parser.FormatTOML
+	FormatXML     = parser.FormatXML
+	FormatCSV     = parser.FormatCSV
+	FormatTSV     = parser.FormatTSV
+	FormatOpenAPI = parser.FormatOpenAPI
+)
+
+var formatLabels = map[Format]string{
+	FormatJSON:    "JSON",
+	FormatYAML:    "YAML",
+	FormatTOML:    "TOML",
+	FormatXML:     "XML",
+	FormatCSV:     "CSV",
+	FormatTSV:     "TSV",
+	FormatOpenAPI: "OpenAPI document",
+}
+
+// NewRegistry returns a registry with every built-in language.
+func NewRegistry() *Registry {
+	return generator.NewGeneratorRegistry()
+}
+
+// ParseFormat validates a format name such as "yaml", ignoring case.
+func ParseFormat(name string) (Format, error) {
+	format := Format(strings.ToLower(name))
+	if _, ok := formatLabels[format]; !ok {
+		return "", fmt.Errorf("input format '%s' not supported", name)
+	}
+	return format, nil
+}
+
+// DetectFormat guesses the input format from a file name's extension.
+func DetectFormat(filename string) (Format, bool) {
+	return parser.DetectFormat(filename)
+}
+
+// Parse infers class definitions from a sample document.
+func Parse(ctx context.Context, data []byte, opts ...Option) ([]ClassDefinition, error) {
+	return newConfig(opts).parse(ctx, [][]byte{data})
+}
+
+// ParseSamples infers class definitions from several documents of the same
+// root type, merging their fields as it does for the elements of a JSON
+// array. Only JSON, YAML and TOML accept more than one sample.
+func ParseSamples(ctx context.Context, samples [][]byte, opts ...Option) ([]ClassDefinition, error) {
+	return newConfig(opts).parse(ctx, samples)
+}
+
+// Generate infers a model from data and returns the code generated for it
+// in language. Languages that emit several files have their contents
+// concatenated; use GenerateFiles to keep them apart.
+func Generate(ctx context.Context, data []byte, language string, opts ...Option) (string, error) {
+	files, err := GenerateFiles(ctx, data, language, opts...)
+	if err != nil {
+		return "", err
+	}
+
+	var buf strings.Builder
+	for _, file := range files {
+		buf.WriteString(file.Content)
+	}
+	return buf.String(), nil
+}
+
+// GenerateFiles infers a model from data and returns the files generated
+// for it in language.
+func GenerateFiles(ctx context.Context, data []byte, language string, opts ...Option) ([]GeneratedFile, error) {
+	cfg := newConfig(opts)
+	classes, err := cfg.parse(ctx, [][]byte{data})
+	if err != nil {
+		return nil, err
+	}
+	return cfg.generate(ctx, classes, language)
+}
+
+// GenerateFromModel returns the files generated for classes in language,
+// for models that were parsed earlier or built by hand.
+func GenerateFromModel(ctx context.Context, classes []ClassDefinition, language string, opts ...Option) ([]GeneratedFile, error) {
+	return newConfig(opts).generate(ctx, classes, language)
+}
+
+// LoadRegistry returns a registry with the built-in languages plus those
+// added by WithTemplates and WithPlugins. Pass it to WithRegistry to share
+// it across calls, so that templates are read and plugins run only once.
+func LoadRegistry(opts ...Option) (*Registry, error) {
+	cfg := newConfig(opts)
+	if cfg.registry != nil {
+		return nil, errors.New("LoadRegistry does not take WithRegistry")
+	}
+	return cfg.resolveRegistry("")
+}
+
+// Languages returns the sorted names of the languages available with opts,
+// including those added by WithTemplates and WithPlugins.
+func Languages(opts ...Option) ([]string, error) {
+	registry, err := newConfig(opts).resolveRegistry("")
+	if err != nil {
+		return nil, err
+	}
+	languages := registry.GetSupportedLanguages()
+	sort.Strings(languages)
+	return languages, nil
+}
+
+func (c *config) parse(ctx context.Context, samples [][]byte) ([]ClassDefinition, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	classes, err := parser.ParseSamples(samples, c.format, c.rootName,
+		parser.WithContext(ctx), parser.WithOverrides(c.overrides))
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, ctxErr
+		}
+		return nil, fmt.Errorf("error parsing %s: %w", formatLabels[c.format], err)
+	}
+	return c.naming.apply(classes), nil
+}
+
+func (c *config) generate(ctx context.Context, classes []ClassDefinition, language string) ([]GeneratedFile, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	registry, err := c.resolveRegistry(language)
+	if err != nil {
+		return nil, err
+	}
+
+	options := Options{}
+	for key, value := range c.options {
+		options[key] = value
+	}
+	// Models decoded from YAML or TOML get matching struct tags in Go.
+	if c.format == FormatYAML || c.format == FormatTOML {
+		if _, ok := options["tags"]; !ok {
+			options["tags"] = string(c.format)
+		}
+	}
+
+	return registry.GenerateFilesContext(ctx, language, classes, options)
+}
+
+// resolveRegistry returns the registry given by WithRegistry, or builds one
+// with the templates and plugins of c. Plugins are only run when language
+// is not otherwise available; an empty language asks for all of them.
+func (c *config) resolveRegistry(language string) (*Registry, error) {
+	if c.registry != nil {
+		if c.templates != "" || c.plugins {
+			return nil, errors.New("WithTemplates and WithPlugins cannot be used with WithRegistry, load them once with LoadRegistry")
+		}
+		return c.registry, nil
+	}
+
+	registry := NewRegistry()
+	if c.templates != "" {
+		if err := registry.LoadTemplates(c.templates); err != nil {
+			return nil, fmt.Errorf("error loading templates: %w", err)
+		}
+	}
+	if _, err := registry.GetLanguage(language); c.plugins && (language == "" || err != nil) {
+		if err := registry.DiscoverPlugins(c.pluginDirs...); err != nil {
+			return nil, fmt.Errorf("error loading plugins: %w", err)
+		}
+	}
+	return registry, nil
+}
@@ -0,0 +1,114 @@
+package converter
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestGenerate(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     string
+		language string
+		opts     []Option
+		want     string
+	}{
+		{"json to go", `{"name": "Alice"}`, "go", []Option{WithRootName("User")}, "type User struct {"},
+		{"generator options", `{"name": "Alice"}`, "typescript", []Option{WithOption("style", "zod")}, "export const RootSchema = z.object({"},
+		{"yaml gets yaml tags", "name: api\n", "go", []Option{WithFormat(FormatYAML)}, "`json:\"name\" yaml:\"name\"`"},
+		{"naming prefix and suffix", `{"name": "Alice"}`, "go", []Option{WithRootName("User"), WithNaming(Naming{Prefix: "Api", Suffix: "DTO"})}, "type ApiUserDTO struct {"},
+		{"naming type overrides", `{"profile": {"bio": "x"}}`, "go", []Option{WithNaming(Naming{Prefix: "Api", Types: map[string]string{"Profile": "UserProfile"}})}, "Profile UserProfile `json:\"profile\"`"},
+		{"overrides", `{"created": "soon", "legacy": 1}`, "typescript", []Option{WithOption("style", "zod"), WithOverrides(map[string]string{"$.created": "datetime", "$.legacy": "skip"})}, "z.object({\n  created: z.string().datetime({ offset: true }),\n});"},
+		{"explicit tags win", "name: api\n", "go", []Option{WithFormat(FormatYAML), WithOptions(Options{"tags": "json"})}, "`json:\"name\"`\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, err := Generate(context.Background(), []byte(tt.data), tt.language, tt.opts...)
+			if err != nil {
+				t.Fatalf("Generate() error = %v", err)
+			}
+			if !strings.Contains(code, tt.want) {
+				t.Errorf("Generate() missing %q:\n%s", tt.want, code)
+			}
+		})
+	}
+}
+
+func TestGenerateErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     string
+		language string
+		opts     []Option
+		wantErr  string
+	}{
+		{"invalid JSON", `{"name":`, "go", nil, "error parsing JSON"},
+		{"invalid YAML", "a: [", "go", []Option{WithFormat(FormatYAML)}, "error parsing YAML"},
+		{"unknown language", `{}`, "cobol", nil, "language 'cobol' not supported"},
+		{"unmatched override", `{"id": 1}`, "go", []Option{WithOverrides(map[string]string{"$.idd": "int64"})}, "override paths match nothing in the input: $.idd"},
+		{"missing templates", `{}`, "go", []Option{WithTemplates("does-not-exist")}, "error loading templates"},
+		{"registry with plugins", `{}`, "go", []Option{WithRegistry(NewRegistry()), WithPlugins()}, "cannot be used with WithRegistry"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Generate(context.Background(), []byte(tt.data), tt.language, tt.opts...)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Generate() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGenerateCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := Generate(ctx, []byte(`{}`), "go"); !errors.Is(err, context.Canceled) {
+		t.Errorf("Generate() error = %v, want context.Canceled", err)
+	}
+	if _, err := Parse(ctx, []byte(`{}`)); !errors.Is(err, context.Canceled) {
+		t.Errorf("Parse() error = %v, want context.Canceled", err)
+	}
+	if _, err := GenerateFromModel(ctx, []ClassDefinition{{Name: "Root"}}, "go"); !errors.Is(err, context.Canceled) {
+		t.Errorf("GenerateFromModel() error = %v, want context.Canceled", err)
+	}
+}
+
+func TestParseSamples(t *testing.T) {
+	samples := [][]byte{[]byte("name: web\n"), []byte("name: worker\nport: 8080\n")}
+	classes, err := ParseSamples(context.Background(), samples, WithFormat(FormatYAML), WithRootName("Service"))
+	if err != nil {
+		t.Fatalf("ParseSamples() error = %v", err)
+	}
+	if len(classes) != 1 || len(classes[0].Fields) != 2 {
+		t.Fatalf("ParseSamples() = %+v, want one class with two fields", classes)
+	}
+
+	_, err = ParseSamples(context.Background(), samples, WithFormat(FormatCSV))
+	if err == nil || !strings.Contains(err.Error(), "error parsing CSV") {
+		t.Errorf("ParseSamples() error = %v, want CSV error", err)
+	}
+}
+
+func TestParseFormat(t *testing.T) {
+	if format, err := ParseFormat("YAML"); err != nil || format != FormatYAML {
+		t.Errorf("ParseFormat(YAML) = %q, %v", format, err)
+	}
+	if _, err := ParseFormat("ini"); err == nil || !strings.Contains(err.Error(), "not supported") {
+		t.Errorf("ParseFormat(ini) error = %v", err)
+	}
+}
+
+func TestLanguages(t *testing.T) {
+	languages, err := Languages()
+	if err != nil {
+		t.Fatalf("Languages() error = %v", err)
+	}
+	if len(languages) < 10 || languages[0] != "avro" {
+		t.Errorf("Languages() = %v, want the sorted built-in languages", languages)
+	}
+}
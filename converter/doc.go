@@ -0,0 +1,34 @@
+// Package converter is the public API of JSON-Converter: it infers class
+// definitions from sample documents and generates models for them in any
+// registered language.
+//
+// The simplest use is a single call:
+//
+//	code, err := converter.Generate(ctx, data, "go", converter.WithRootName("User"))
+//
+// Parse and GenerateFromModel split the two steps, so the inferred model can
+// be inspected or adjusted in between. A Registry holds the available
+// languages; it can be extended with user templates and external plugins
+// and shared across calls with WithRegistry; LoadRegistry builds one that
+// reads the templates and runs the plugins only once.
+//
+// Every call stops once its context is done: parsing is abandoned and
+// plugins are killed, while a built-in generator finishes the file it is
+// writing.
+//
+// # Compatibility
+//
+// The package follows semantic versioning, reported by Version. Within a
+// major version:
+//
+//   - exported identifiers are not removed and their signatures do not
+//     change;
+//   - fields may be added to ClassDefinition, FieldDefinition and
+//     Constraints, so construct them with field names;
+//   - new options, languages and input formats may be added;
+//   - generated code may change between minor versions, for example to
+//     fix a mapping, but not between patch versions unless it was invalid.
+//
+// Everything under internal/ is an implementation detail with no
+// compatibility promise.
+package converter
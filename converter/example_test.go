@@ -0,0 +1,70 @@
+package converter_test
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/jguerreno/JSON-Converter/converter"
+)
+
+func ExampleGenerate() {
+	data := []byte(`{"id": 1, "email": "alice@example.com"}`)
+
+	code, err := converter.Generate(context.Background(), data, "typescript", converter.WithRootName("User"))
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Print(code)
+	// Output:
+	// export interface User {
+	//   email: string;
+	//   id: number;
+	// }
+}
+
+func ExampleParse() {
+	data := []byte("name: api\nreplicas: 3\n")
+
+	classes, err := converter.Parse(context.Background(), data,
+		converter.WithFormat(converter.FormatYAML),
+		converter.WithRootName("Service"),
+	)
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, field := range classes[0].Fields {
+		fmt.Printf("%s.%s %s\n", classes[0].Name, field.Name, field.TypeName)
+	}
+	// Output:
+	// Service.Name string
+	// Service.Replicas int
+}
+
+func ExampleGenerateFromModel() {
+	classes := []converter.ClassDefinition{
+		{
+			Name: "Point",
+			Fields: []converter.FieldDefinition{
+				{Name: "X", JSONTag: "x", TypeName: "float64"},
+				{Name: "Y", JSONTag: "y", TypeName: "float64"},
+			},
+		},
+	}
+
+	files, err := converter.GenerateFromModel(context.Background(), classes, "typescript", converter.WithOption("style", "zod"))
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(files[0].Name)
+	fmt.Print(files[0].Content)
+	// Output:
+	// models.ts
+	// import { z } from "zod";
+	//
+	// export const PointSchema = z.object({
+	//   x: z.number(),
+	//   y: z.number(),
+	// });
+	// export type Point = z.infer<typeof PointSchema>;
+}
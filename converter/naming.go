@@ -0,0 +1,49 @@
+package converter
+
+// Naming renames the inferred classes. Types maps an inferred class name to
+// the exact name to use instead; the other classes get Prefix and Suffix
+// around their inferred name. Field types follow their class.
+type Naming struct {
+	Prefix string
+	Suffix string
+	Types  map[string]string
+}
+
+func (n Naming) isZero() bool {
+	return n.Prefix == "" && n.Suffix == "" && len(n.Types) == 0
+}
+
+func (n Naming) rename(name string) string {
+	if renamed, ok := n.Types[name]; ok {
+		return renamed
+	}
+	return n.Prefix + name + n.Suffix
+}
+
+// apply returns classes with the naming rules applied to the class names
+// and to the fields that refer to them, leaving classes untouched.
+func (n Naming) apply(classes []ClassDefinition) []ClassDefinition {
+	if n.isZero() {
+		return classes
+	}
+
+	names := make(map[string]string, len(classes))
+	for _, class := range classes {
+		names[class.Name] = n.rename(class.Name)
+	}
+
+	renamed := make([]ClassDefinition, len(classes))
+	for i, class := range classes {
+		class.Name = names[class.Name]
+		fields := make([]FieldDefinition, len(class.Fields))
+		for j, field := range class.Fields {
+			if name, ok := names[field.TypeName]; ok {
+				field.TypeName = name
+			}
+			fields[j] = field
+		}
+		class.Fields = fields
+		renamed[i] = class
+	}
+	return renamed
+}
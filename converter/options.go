@@ -0,0 +1,110 @@
+package converter
+
+// Option configures Parse, Generate and the other entry points.
+type Option func(*config)
+
+type config struct {
+	rootName   string
+	format     Format
+	options    Options
+	registry   *Registry
+	templates  string
+	plugins    bool
+	pluginDirs []string
+	naming     Naming
+	overrides  map[string]string
+}
+
+func newConfig(opts []Option) *config {
+	cfg := &config{
+		rootName:  "Root",
+		format:    FormatJSON,
+		options:   Options{},
+		overrides: map[string]string{},
+	}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+	return cfg
+}
+
+// WithRootName names the class inferred for the document root. The default
+// is "Root".
+func WithRootName(name string) Option {
+	return func(c *config) {
+		c.rootName = name
+	}
+}
+
+// WithFormat sets the input format. The default is FormatJSON.
+func WithFormat(format Format) Option {
+	return func(c *config) {
+		c.format = format
+	}
+}
+
+// WithOption sets one generator option, such as WithOption("style", "zod").
+func WithOption(key, value string) Option {
+	return func(c *config) {
+		c.options[key] = value
+	}
+}
+
+// WithOptions sets several generator options at once.
+func WithOptions(options Options) Option {
+	return func(c *config) {
+		for key, value := range options {
+			c.options[key] = value
+		}
+	}
+}
+
+// WithRegistry generates with registry instead of a new one with the
+// built-in languages. It cannot be combined with WithTemplates or
+// WithPlugins; use LoadRegistry to build a registry with them.
+func WithRegistry(registry *Registry) Option {
+	return func(c *config) {
+		c.registry = registry
+	}
+}
+
+// WithTemplates loads user templates from a .tmpl file or a directory of
+// them, overriding built-in languages or adding new ones. They are read
+// again on every call; see LoadRegistry.
+func WithTemplates(path string) Option {
+	return func(c *config) {
+		c.templates = path
+	}
+}
+
+// WithPlugins enables json-converter-gen-<lang> plugins, searched in dirs
+// and then on PATH. They are only run when the requested language is not
+// built in.
+func WithPlugins(dirs ...string) Option {
+	return func(c *config) {
+		c.plugins = true
+		c.pluginDirs = append(c.pluginDirs, dirs...)
+	}
+}
+
+// WithNaming renames the classes inferred by Parse and Generate, for example
+// to add a prefix or give a nested class a better name than its field's.
+func WithNaming(naming Naming) Option {
+	return func(c *config) {
+		c.naming = naming
+	}
+}
+
+// WithOverrides corrects the inferred model by JSONPath, for example
+// {"$.created_at": "datetime", "$.items[*]": "OrderLine", "$.legacy": "skip"}.
+// Paths are those reported in ClassDefinition.Path and FieldDefinition.Path;
+// values are a scalar type (string, int, int64, float64, bool, any), a string
+// format (datetime, date, uuid), map<string,T>, skip, or a class name. Parse
+// fails when an override matches nothing in the input.
+func WithOverrides(overrides map[string]string) Option {
+	return func(c *config) {
+		for path, spec := range overrides {
+			c.overrides[path] = spec
+		}
+	}
+}
@@ -1,3 +1,8 @@
 module github.com/jguerreno/JSON-Converter
 
 go 1.23.6
+
+require (
+	github.com/BurntSushi/toml v1.4.0
+	gopkg.in/yaml.v3 v3.0.1
+)
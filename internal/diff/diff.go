@@ -0,0 +1,226 @@
+// Package diff produces unified diffs of text, line by line.
+package diff
+
+import (
+	"fmt"
+	"strings"
+)
+
+// contextLines is the number of unchanged lines shown around each change.
+const contextLines = 3
+
+type opKind byte
+
+const (
+	opEqual  opKind = ' '
+	opDelete opKind = '-'
+	opInsert opKind = '+'
+)
+
+type op struct {
+	kind opKind
+	line string
+}
+
+// Unified returns the differences between oldText and newText in unified
+// format, labelling them oldName and newName. It returns "" when the texts
+// are equal.
+func Unified(oldName, newName, oldText, newText string) string {
+	if oldText == newText {
+		return ""
+	}
+
+	ops := editScript(splitLines(oldText), splitLines(newText))
+
+	var buf strings.Builder
+	fmt.Fprintf(&buf, "--- %s\n+++ %s\n", oldName, newName)
+	for _, h := range hunks(ops) {
+		h.write(&buf, ops)
+	}
+	return buf.String()
+}
+
+// splitLines splits text after every newline. A last line without one is
+// marked so that a missing trailing newline shows up in the diff.
+func splitLines(text string) []string {
+	if text == "" {
+		return nil
+	}
+	lines := strings.SplitAfter(text, "\n")
+	if lines[len(lines)-1] == "" {
+		return lines[:len(lines)-1]
+	}
+	lines[len(lines)-1] += "\n\\ No newline at end of file\n"
+	return lines
+}
+
+// maxEditDistance bounds the work spent looking for a shortest edit script.
+// Past it, the texts are reported as entirely replaced.
+const maxEditDistance = 4000
+
+// editScript finds a shortest edit script turning a into b with Myers'
+// O(ND) algorithm.
+func editScript(a, b []string) []op {
+	n, m := len(a), len(b)
+	limit := min(n+m, maxEditDistance)
+	offset := limit + 1
+	v := make([]int, 2*limit+3)
+	// trace[d] holds the frontier v[-d..d] reached before step d.
+	var trace [][]int
+
+	for d := 0; d <= limit; d++ {
+		trace = append(trace, append([]int(nil), v[offset-d:offset+d+1]...))
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+				x = v[offset+k+1]
+			} else {
+				x = v[offset+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+			v[offset+k] = x
+			if x >= n && y >= m {
+				return backtrack(a, b, trace, d)
+			}
+		}
+	}
+	return replaceAll(a, b)
+}
+
+// backtrack walks the saved frontiers from the end of both inputs back to
+// the start, collecting the edits in reverse.
+func backtrack(a, b []string, trace [][]int, d int) []op {
+	x, y := len(a), len(b)
+	var ops []op
+	for ; d > 0; d-- {
+		v := trace[d]
+		k := x - y
+		var prevK int
+		if k == -d || (k != d && v[d+k-1] < v[d+k+1]) {
+			prevK = k + 1
+		} else {
+			prevK = k - 1
+		}
+		prevX := v[d+prevK]
+		prevY := prevX - prevK
+
+		for x > prevX && y > prevY {
+			x--
+			y--
+			ops = append(ops, op{opEqual, a[x]})
+		}
+		if x == prevX {
+			y--
+			ops = append(ops, op{opInsert, b[y]})
+		} else {
+			x--
+			ops = append(ops, op{opDelete, a[x]})
+		}
+	}
+	for x > 0 {
+		x--
+		ops = append(ops, op{opEqual, a[x]})
+	}
+
+	for i, j := 0, len(ops)-1; i < j; i, j = i+1, j-1 {
+		ops[i], ops[j] = ops[j], ops[i]
+	}
+	return ops
+}
+
+func replaceAll(a, b []string) []op {
+	ops := make([]op, 0, len(a)+len(b))
+	for _, line := range a {
+		ops = append(ops, op{opDelete, line})
+	}
+	for _, line := range b {
+		ops = append(ops, op{opInsert, line})
+	}
+	return ops
+}
+
+// hunk is a run of ops[start:end] holding changes and their context.
+type hunk struct {
+	start, end       int
+	oldLine, newLine int
+}
+
+func hunks(ops []op) []hunk {
+	var result []hunk
+	oldLine, newLine := 0, 0
+	lineAt := make([][2]int, len(ops)+1)
+	for i, o := range ops {
+		lineAt[i] = [2]int{oldLine, newLine}
+		if o.kind != opInsert {
+			oldLine++
+		}
+		if o.kind != opDelete {
+			newLine++
+		}
+	}
+	lineAt[len(ops)] = [2]int{oldLine, newLine}
+
+	for i := 0; i < len(ops); i++ {
+		if ops[i].kind == opEqual {
+			continue
+		}
+		start := max(i-contextLines, 0)
+		end := i
+		// Extend over changes separated by less than two contexts' worth
+		// of equal lines, so that nearby changes share one hunk.
+		for end < len(ops) {
+			if ops[end].kind != opEqual {
+				end++
+				continue
+			}
+			run := end
+			for run < len(ops) && ops[run].kind == opEqual {
+				run++
+			}
+			if run == len(ops) || run-end > 2*contextLines {
+				end = min(end+contextLines, len(ops))
+				break
+			}
+			end = run
+		}
+		result = append(result, hunk{start: start, end: end, oldLine: lineAt[start][0], newLine: lineAt[start][1]})
+		i = end - 1
+	}
+	return result
+}
+
+func (h hunk) write(buf *strings.Builder, ops []op) {
+	oldCount, newCount := 0, 0
+	for _, o := range ops[h.start:h.end] {
+		if o.kind != opInsert {
+			oldCount++
+		}
+		if o.kind != opDelete {
+			newCount++
+		}
+	}
+
+	fmt.Fprintf(buf, "@@ -%s +%s @@\n", hunkRange(h.oldLine, oldCount), hunkRange(h.newLine, newCount))
+	for _, o := range ops[h.start:h.end] {
+		buf.WriteByte(byte(o.kind))
+		buf.WriteString(o.line)
+	}
+}
+
+// hunkRange formats a 0-based start line and a line count the way diff -u
+// does: 1-based, with the count omitted when it is 1 and the start being the
+// line before the hunk when it is empty.
+func hunkRange(start, count int) string {
+	switch count {
+	case 0:
+		return fmt.Sprintf("%d,0", start)
+	case 1:
+		return fmt.Sprintf("%d", start+1)
+	default:
+		return fmt.Sprintf("%d,%d", start+1, count)
+	}
+}
@@ -0,0 +1,46 @@
+package diff
+
+import "testing"
+
+func TestUnified(t *testing.T) {
+	tests := []struct {
+		name     string
+		old, new string
+		want     string
+	}{
+		{"equal", "a\nb\n", "a\nb\n", ""},
+		{
+			"changed line",
+			"a\nb\nc\n", "a\nB\nc\n",
+			"--- old\n+++ new\n@@ -1,3 +1,3 @@\n a\n-b\n+B\n c\n",
+		},
+		{
+			"new file",
+			"", "a\nb\n",
+			"--- old\n+++ new\n@@ -0,0 +1,2 @@\n+a\n+b\n",
+		},
+		{
+			"missing trailing newline",
+			"a\n", "a",
+			"--- old\n+++ new\n@@ -1 +1 @@\n-a\n+a\n\\ No newline at end of file\n",
+		},
+		{
+			"distant changes get separate hunks",
+			"1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n", "one\n2\n3\n4\n5\n6\n7\n8\n9\nten\n",
+			"--- old\n+++ new\n@@ -1,4 +1,4 @@\n-1\n+one\n 2\n 3\n 4\n@@ -7,4 +7,4 @@\n 7\n 8\n 9\n-10\n+ten\n",
+		},
+		{
+			"close changes share a hunk",
+			"1\n2\n3\n4\n5\n", "one\n2\n3\n4\nfive\n",
+			"--- old\n+++ new\n@@ -1,5 +1,5 @@\n-1\n+one\n 2\n 3\n 4\n-5\n+five\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Unified("old", "new", tt.old, tt.new); got != tt.want {
+				t.Errorf("Unified() =\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+}
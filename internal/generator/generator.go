@@ -1,6 +1,7 @@
 package generator
 
 import (
+	"context"
 	"fmt"
 	"sync"
 
@@ -10,24 +11,52 @@ import (
 
 type LanguageGenerator interface {
 	Generate(classes []models.ClassDefinition) (string, error)
+	GenerateWithOptions(classes []models.ClassDefinition, opts models.Options) (string, error)
 	GetName() string
 	GetFileExtension() string
 }
 
+// GeneratedFile is one output file. Name is relative to the output
+// directory.
+type GeneratedFile struct {
+	Name    string `json:"name"`
+	Content string `json:"content"`
+}
+
+// FileGenerator is implemented by generators that may emit several files,
+// such as plugins.
+type FileGenerator interface {
+	GenerateFiles(classes []models.ClassDefinition, opts models.Options) ([]GeneratedFile, error)
+}
+
+// ContextFileGenerator is implemented by generators whose work can be
+// interrupted, such as plugins, which are killed once ctx is done.
+type ContextFileGenerator interface {
+	GenerateFilesContext(ctx context.Context, classes []models.ClassDefinition, opts models.Options) ([]GeneratedFile, error)
+}
+
 type GeneratorRegistry struct {
 	generators map[string]LanguageGenerator
+	skipped    map[string]error
 	mu         sync.RWMutex
 }
 
 func NewGeneratorRegistry() *GeneratorRegistry {
 	registry := &GeneratorRegistry{
 		generators: make(map[string]LanguageGenerator),
+		skipped:    make(map[string]error),
 	}
 
 	registry.Register(languages.NewGoGenerator())
 	registry.Register(languages.NewPythonGenerator())
 	registry.Register(languages.NewTypeScriptGenerator())
 	registry.Register(languages.NewJavaGenerator())
+	registry.Register(languages.NewProtoGenerator())
+	registry.Register(languages.NewGraphQLGenerator())
+	registry.Register(languages.NewSQLGenerator())
+	registry.Register(languages.NewAvroGenerator())
+	registry.Register(languages.NewParquetGenerator())
+	registry.Register(languages.NewJSONSchemaGenerator())
 
 	return registry
 }
@@ -39,15 +68,47 @@ func (r *GeneratorRegistry) Register(gen LanguageGenerator) {
 }
 
 func (r *GeneratorRegistry) Generate(language string, classes []models.ClassDefinition) (string, error) {
-	r.mu.RLock()
-	gen, ok := r.generators[language]
-	r.mu.RUnlock()
+	return r.GenerateWithOptions(language, classes, nil)
+}
 
-	if !ok {
-		return "", fmt.Errorf("language '%s' not supported", language)
+func (r *GeneratorRegistry) GenerateWithOptions(language string, classes []models.ClassDefinition, opts models.Options) (string, error) {
+	gen, err := r.GetLanguage(language)
+	if err != nil {
+		return "", err
 	}
 
-	return gen.Generate(classes)
+	return gen.GenerateWithOptions(classes, opts)
+}
+
+// GenerateFiles returns the files generated for language. Generators that
+// produce a single string yield one file named models.<extension>.
+func (r *GeneratorRegistry) GenerateFiles(language string, classes []models.ClassDefinition, opts models.Options) ([]GeneratedFile, error) {
+	return r.GenerateFilesContext(context.Background(), language, classes, opts)
+}
+
+// GenerateFilesContext is GenerateFiles for a generation that stops once
+// ctx is done. Built-in generators are not interrupted but do not start
+// after ctx is done; a ContextFileGenerator is given ctx.
+func (r *GeneratorRegistry) GenerateFilesContext(ctx context.Context, language string, classes []models.ClassDefinition, opts models.Options) ([]GeneratedFile, error) {
+	gen, err := r.GetLanguage(language)
+	if err != nil {
+		return nil, err
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if ctxGen, ok := gen.(ContextFileGenerator); ok {
+		return ctxGen.GenerateFilesContext(ctx, classes, opts)
+	}
+	if fileGen, ok := gen.(FileGenerator); ok {
+		return fileGen.GenerateFiles(classes, opts)
+	}
+
+	content, err := gen.GenerateWithOptions(classes, opts)
+	if err != nil {
+		return nil, err
+	}
+	return []GeneratedFile{{Name: "models." + gen.GetFileExtension(), Content: content}}, nil
 }
 
 func (r *GeneratorRegistry) GetSupportedLanguages() []string {
@@ -66,6 +127,9 @@ func (r *GeneratorRegistry) GetLanguage(language string) (LanguageGenerator, err
 	defer r.mu.RUnlock()
 	gen, ok := r.generators[language]
 	if !ok {
+		if err, skipped := r.skipped[language]; skipped {
+			return nil, fmt.Errorf("language '%s' not supported: %w", language, err)
+		}
 		return nil, fmt.Errorf("language '%s' not supported", language)
 	}
 
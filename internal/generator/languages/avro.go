@@ -0,0 +1,170 @@
+package languages
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+
+	"github.com/jguerreno/JSON-Converter/internal/conventions"
+	"github.com/jguerreno/JSON-Converter/internal/models"
+)
+
+var avroName = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// AvroGenerator emits an Avro record schema (.avsc) for the root class.
+// Nested classes are defined inline on first use and referenced by name
+// afterwards; several root classes are emitted as a union.
+type AvroGenerator struct{}
+
+type avroRecord struct {
+	Type      string      `json:"type"`
+	Name      string      `json:"name"`
+	Namespace string      `json:"namespace,omitempty"`
+	Fields    []avroField `json:"fields"`
+}
+
+type avroField struct {
+	Name    string          `json:"name"`
+	Type    interface{}     `json:"type"`
+	Default json.RawMessage `json:"default,omitempty"`
+}
+
+type avroLogicalType struct {
+	Type        string `json:"type"`
+	LogicalType string `json:"logicalType"`
+}
+
+type avroArray struct {
+	Type  string      `json:"type"`
+	Items interface{} `json:"items"`
+}
+
+type avroMap struct {
+	Type   string      `json:"type"`
+	Values interface{} `json:"values"`
+}
+
+type avroBuilder struct {
+	classes   map[string]models.ClassDefinition
+	defined   map[string]bool
+	namespace string
+}
+
+func NewAvroGenerator() *AvroGenerator {
+	return &AvroGenerator{}
+}
+
+func (a *AvroGenerator) GetName() string {
+	return "avro"
+}
+
+func (a *AvroGenerator) GetFileExtension() string {
+	return "avsc"
+}
+
+func (a *AvroGenerator) Generate(classes []models.ClassDefinition) (string, error) {
+	return a.GenerateWithOptions(classes, nil)
+}
+
+func (a *AvroGenerator) GenerateWithOptions(classes []models.ClassDefinition, opts models.Options) (string, error) {
+	builder := &avroBuilder{
+		classes:   make(map[string]models.ClassDefinition),
+		defined:   make(map[string]bool),
+		namespace: opts.Get("namespace", ""),
+	}
+	for _, class := range classes {
+		builder.classes[class.Name] = class
+	}
+
+	schemas := []interface{}{}
+	for _, root := range rootClasses(classes) {
+		schemas = append(schemas, builder.record(root))
+	}
+
+	var schema interface{} = schemas
+	if len(schemas) == 1 {
+		schema = schemas[0]
+	}
+
+	output, err := json.MarshalIndent(schema, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to encode schema for %s: %w", a.GetName(), err)
+	}
+	return string(output) + "\n", nil
+}
+
+func (b *avroBuilder) record(class models.ClassDefinition) interface{} {
+	if b.defined[class.Name] {
+		return class.Name
+	}
+	b.defined[class.Name] = true
+
+	record := avroRecord{
+		Type:      "record",
+		Name:      class.Name,
+		Namespace: b.namespace,
+		Fields:    []avroField{},
+	}
+	b.namespace = ""
+
+	for _, field := range class.Fields {
+		avroType := b.fieldType(field)
+		entry := avroField{Name: formatAvroFieldName(field), Type: avroType}
+		if field.IsOptional {
+			entry.Type = []interface{}{"null", avroType}
+			entry.Default = json.RawMessage("null")
+		}
+		record.Fields = append(record.Fields, entry)
+	}
+	return record
+}
+
+func (b *avroBuilder) fieldType(field models.FieldDefinition) interface{} {
+	var valueType interface{}
+	if class, ok := b.classes[field.TypeName]; ok {
+		valueType = b.record(class)
+	} else {
+		valueType = convertAvroType(field)
+	}
+
+	if field.IsMap {
+		valueType = avroMap{Type: "map", Values: valueType}
+	}
+	if field.IsList {
+		valueType = avroArray{Type: "array", Items: valueType}
+	}
+	return valueType
+}
+
+func convertAvroType(field models.FieldDefinition) interface{} {
+	switch field.TypeName {
+	case "string":
+		switch field.Format {
+		case "date-time":
+			return avroLogicalType{Type: "long", LogicalType: "timestamp-millis"}
+		case "date":
+			return avroLogicalType{Type: "int", LogicalType: "date"}
+		case "uuid":
+			return avroLogicalType{Type: "string", LogicalType: "uuid"}
+		}
+		return "string"
+	case "int", "int64":
+		return "long"
+	case "float64":
+		return "double"
+	case "bool":
+		return "boolean"
+	case "interface{}":
+		// Avro has no dynamic type; arbitrary values are kept as JSON text.
+		return "string"
+	default:
+		return field.TypeName
+	}
+}
+
+func formatAvroFieldName(field models.FieldDefinition) string {
+	if avroName.MatchString(field.JSONTag) {
+		return field.JSONTag
+	}
+	return conventions.ToSnakeCase(field.Name)
+}
@@ -0,0 +1,93 @@
+package languages
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/jguerreno/JSON-Converter/internal/models"
+)
+
+var avroTestClasses = []models.ClassDefinition{
+	{
+		Name: "Address",
+		Fields: []models.FieldDefinition{
+			{Name: "City", JSONTag: "city", TypeName: "string"},
+		},
+	},
+	{
+		Name: "Order",
+		Fields: []models.FieldDefinition{
+			{Name: "Id", JSONTag: "id", TypeName: "string", Format: "uuid"},
+			{Name: "CreatedAt", JSONTag: "created-at", TypeName: "string", Format: "date-time"},
+			{Name: "Note", JSONTag: "note", TypeName: "string", IsOptional: true},
+			{Name: "Billing", JSONTag: "billing", TypeName: "Address"},
+			{Name: "Shipping", JSONTag: "shipping", TypeName: "Address", IsOptional: true},
+			{Name: "Tags", JSONTag: "tags", TypeName: "string", IsList: true},
+			{Name: "Counts", JSONTag: "counts", TypeName: "int", IsMap: true},
+		},
+	},
+}
+
+func TestConvertAvroType(t *testing.T) {
+	tests := []struct {
+		field models.FieldDefinition
+		want  string
+	}{
+		{models.FieldDefinition{TypeName: "string"}, `"string"`},
+		{models.FieldDefinition{TypeName: "int"}, `"long"`},
+		{models.FieldDefinition{TypeName: "float64"}, `"double"`},
+		{models.FieldDefinition{TypeName: "bool"}, `"boolean"`},
+		{models.FieldDefinition{TypeName: "interface{}"}, `"string"`},
+		{models.FieldDefinition{TypeName: "string", Format: "date"}, `{"type":"int","logicalType":"date"}`},
+		{models.FieldDefinition{TypeName: "string", Format: "uuid"}, `{"type":"string","logicalType":"uuid"}`},
+	}
+
+	for _, tt := range tests {
+		result, err := json.Marshal(convertAvroType(tt.field))
+		if err != nil {
+			t.Fatalf("convertAvroType(%v) is not encodable: %v", tt.field, err)
+		}
+		if string(result) != tt.want {
+			t.Errorf("convertAvroType(%v) = %s, want %s", tt.field, result, tt.want)
+		}
+	}
+}
+
+func TestGenerateAvro(t *testing.T) {
+	code, err := NewAvroGenerator().GenerateWithOptions(avroTestClasses, models.Options{"namespace": "com.example"})
+	if err != nil {
+		t.Fatalf("GenerateAvro failed: %v", err)
+	}
+
+	var schema map[string]interface{}
+	if err := json.Unmarshal([]byte(code), &schema); err != nil {
+		t.Fatalf("generated schema is not valid JSON: %v\n%s", err, code)
+	}
+	if schema["name"] != "Order" || schema["namespace"] != "com.example" {
+		t.Errorf("unexpected root record: %v", schema)
+	}
+
+	expectedCode := []string{
+		`"name": "created_at"`,
+		`"logicalType": "timestamp-millis"`,
+		`"type": "array",`,
+		`"items": "string"`,
+		`"type": "map",`,
+		`"values": "long"`,
+		`"default": null`,
+	}
+
+	for _, expected := range expectedCode {
+		if !strings.Contains(code, expected) {
+			t.Errorf("Generated Avro schema missing: %q", expected)
+		}
+	}
+
+	if count := strings.Count(code, `"name": "Address"`); count != 1 {
+		t.Errorf("nested record should be defined once, got %d definitions", count)
+	}
+	if !strings.Contains(code, "\"null\",\n        \"Address\"") {
+		t.Errorf("later uses of a record should reference it by name, got:\n%s", code)
+	}
+}
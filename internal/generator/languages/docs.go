@@ -0,0 +1,43 @@
+package languages
+
+import (
+	"strings"
+
+	"github.com/jguerreno/JSON-Converter/internal/models"
+)
+
+// formatFieldDoc describes a field for the doc comments emitted with the
+// "docs" option, e.g. `Example: "alice@example.com" (path: $.users[*].email)`.
+// It is empty when the parser recorded neither examples nor a path.
+func formatFieldDoc(field models.FieldDefinition) string {
+	var doc string
+	switch len(field.Examples) {
+	case 0:
+	case 1:
+		doc = "Example: " + field.Examples[0]
+	default:
+		doc = "Examples: " + strings.Join(field.Examples, ", ")
+	}
+
+	switch {
+	case field.Path == "":
+		return doc
+	case doc == "":
+		return "Path: " + field.Path
+	default:
+		return doc + " (path: " + field.Path + ")"
+	}
+}
+
+// formatClassDoc describes where in the sample a class was inferred from.
+func formatClassDoc(class models.ClassDefinition) string {
+	if class.Path == "" {
+		return ""
+	}
+	return "Path: " + class.Path
+}
+
+// formatBlockDoc makes a doc string safe inside a /** */ comment.
+func formatBlockDoc(doc string) string {
+	return strings.ReplaceAll(doc, "*/", "*\\/")
+}
@@ -0,0 +1,42 @@
+package languages
+
+import (
+	"testing"
+
+	"github.com/jguerreno/JSON-Converter/internal/models"
+)
+
+var documentedClasses = []models.ClassDefinition{
+	{
+		Name: "UsersItem",
+		Path: "$.users[*]",
+		Fields: []models.FieldDefinition{
+			{Name: "Email", JSONTag: "email", TypeName: "string", Examples: []string{`"alice@example.com"`}, Path: "$.users[*].email"},
+			{Name: "Age", JSONTag: "age", TypeName: "int", IsOptional: true, Examples: []string{"30", "41"}, Path: "$.users[*].age"},
+		},
+	},
+}
+
+func TestFormatFieldDoc(t *testing.T) {
+	tests := []struct {
+		field models.FieldDefinition
+		want  string
+	}{
+		{documentedClasses[0].Fields[0], `Example: "alice@example.com" (path: $.users[*].email)`},
+		{documentedClasses[0].Fields[1], `Examples: 30, 41 (path: $.users[*].age)`},
+		{models.FieldDefinition{Path: "$.users"}, "Path: $.users"},
+		{models.FieldDefinition{Examples: []string{"true"}}, "Example: true"},
+		{models.FieldDefinition{}, ""},
+	}
+
+	for _, tt := range tests {
+		result := formatFieldDoc(tt.field)
+		if result != tt.want {
+			t.Errorf("formatFieldDoc(%v) = %q, want %q", tt.field, result, tt.want)
+		}
+	}
+
+	if result := formatBlockDoc(`Example: "*/" (path: $.a)`); result != `Example: "*\/" (path: $.a)` {
+		t.Errorf("formatBlockDoc did not escape the comment terminator: %q", result)
+	}
+}
@@ -2,19 +2,25 @@ package languages
 
 import (
 	"fmt"
+	"sort"
+	"strconv"
 	"strings"
 	"text/template"
 
 	"github.com/jguerreno/JSON-Converter/internal/models"
 )
 
+// GoGenerator emits Go structs. With the "codec" option it also emits
+// MarshalJSON and UnmarshalJSON methods that check required fields, and
+// with "validate" it adds go-playground/validator tags. "docs" adds
+// comments with the JSON path and example values of each field.
 type GoGenerator struct {
 	template *template.Template
 }
 
 func NewGoGenerator() *GoGenerator {
 	return &GoGenerator{
-		template: template.Must(template.New("go").Funcs(getGoTemplateFuncs()).Parse(goTemplate)),
+		template: template.Must(template.Must(template.New("go").Funcs(getGoTemplateFuncs()).Parse(goTemplate)).Parse(goCodecTemplate)),
 	}
 }
 
@@ -26,10 +32,24 @@ func (g *GoGenerator) GetFileExtension() string {
 	return "go"
 }
 
+func (g *GoGenerator) TemplateFuncs() template.FuncMap {
+	return getGoTemplateFuncs()
+}
+
 func (g *GoGenerator) Generate(classes []models.ClassDefinition) (string, error) {
+	return g.GenerateWithOptions(classes, nil)
+}
+
+func (g *GoGenerator) GenerateWithOptions(classes []models.ClassDefinition, opts models.Options) (string, error) {
 	var buf strings.Builder
 	if err := g.template.Execute(&buf, map[string]interface{}{
-		"Classes": classes,
+		"Classes":  classes,
+		"Options":  opts,
+		"Tags":     opts.List("tags"),
+		"Codec":    opts.Bool("codec"),
+		"Validate": opts.Bool("validate"),
+		"Docs":     opts.Bool("docs"),
+		"Imports":  goImports(classes, opts.Bool("codec")),
 	}); err != nil {
 		return "", fmt.Errorf("failed to execute template for %s: %w", g.GetName(), err)
 	}
@@ -43,8 +63,16 @@ func convertGoType(goType string) string {
 
 func getGoTemplateFuncs() template.FuncMap {
 	return template.FuncMap{
+		"convertType":   convertGoType,
 		"formatType":    formatGoType,
 		"formatJsonTag": formatGoJsonTag,
+		"formatTags":    formatGoTags,
+		"goDecoder":     formatGoDecoder,
+		"goEncoder":     formatGoEncoder,
+		"omitCheck":     formatGoOmitCheck,
+		"validateTag":   formatGoValidateTag,
+		"fieldDoc":      formatFieldDoc,
+		"classDoc":      formatClassDoc,
 	}
 }
 
@@ -53,7 +81,9 @@ func formatGoType(field models.FieldDefinition) string {
 	if field.IsList {
 		typeBuilder.WriteString("[]")
 	}
-	if field.IsOptional {
+	if field.IsMap {
+		typeBuilder.WriteString("map[string]")
+	} else if field.IsOptional {
 		typeBuilder.WriteString("*")
 	}
 	typeBuilder.WriteString(convertGoType(field.TypeName))
@@ -69,14 +99,140 @@ func formatGoJsonTag(field models.FieldDefinition) string {
 	return tagBuilder.String()
 }
 
+// formatGoTags renders the struct tag for a field: always a json key,
+// followed by one key per extra encoding requested with the "tags" option.
+func formatGoTags(field models.FieldDefinition, extraTags []string) string {
+	var tagBuilder strings.Builder
+	tagBuilder.WriteString(`json:"` + formatGoJsonTag(field) + `"`)
+	for _, tag := range extraTags {
+		if tag == "json" {
+			continue
+		}
+		tagBuilder.WriteString(" " + tag + `:"` + formatGoJsonTag(field) + `"`)
+	}
+	if field.XMLKind != "" {
+		tagBuilder.WriteString(` xml:"` + formatGoXMLTag(field) + `"`)
+	}
+	return tagBuilder.String()
+}
+
+// formatGoValidateTag renders a validator tag from the field's constraints.
+// "required" is only used where the zero value cannot be valid data, since
+// validator treats 0, false and "" as missing.
+func formatGoValidateTag(field models.FieldDefinition) string {
+	rules := []string{}
+	constraints := field.Constraints
+	if constraints == nil {
+		constraints = &models.Constraints{}
+	}
+
+	switch {
+	case field.IsOptional:
+		rules = append(rules, "omitempty")
+	case field.IsList || field.IsMap:
+		rules = append(rules, "required")
+	case field.TypeName == "string" && constraints.MinLength != nil && *constraints.MinLength > 0:
+		rules = append(rules, "required")
+	}
+
+	switch {
+	case field.IsList:
+		rules = appendIntRule(rules, "min", constraints.MinItems)
+		rules = appendIntRule(rules, "max", constraints.MaxItems)
+		if !isPrimitiveType(field.TypeName) && !field.IsMap {
+			rules = append(rules, "dive")
+		}
+	case field.IsMap:
+	case field.TypeName == "string":
+		rules = appendIntRule(rules, "min", constraints.MinLength)
+		rules = appendIntRule(rules, "max", constraints.MaxLength)
+	default:
+		if constraints.Minimum != nil {
+			rules = append(rules, "gte="+strconv.FormatFloat(*constraints.Minimum, 'f', -1, 64))
+		}
+		if constraints.Maximum != nil {
+			rules = append(rules, "lte="+strconv.FormatFloat(*constraints.Maximum, 'f', -1, 64))
+		}
+	}
+
+	if len(rules) == 0 || (len(rules) == 1 && rules[0] == "omitempty") {
+		return ""
+	}
+	return ` validate:"` + strings.Join(rules, ",") + `"`
+}
+
+func appendIntRule(rules []string, name string, value *int) []string {
+	if value == nil || (name == "min" && *value == 0) {
+		return rules
+	}
+	return append(rules, name+"="+strconv.Itoa(*value))
+}
+
+func formatGoXMLTag(field models.FieldDefinition) string {
+	switch field.XMLKind {
+	case models.XMLCharData:
+		return ",chardata"
+	case models.XMLAttribute:
+		return field.JSONTag + ",attr" + formatGoOmitEmpty(field)
+	default:
+		return field.JSONTag + formatGoOmitEmpty(field)
+	}
+}
+
+func formatGoOmitEmpty(field models.FieldDefinition) string {
+	if field.IsOptional {
+		return ",omitempty"
+	}
+	return ""
+}
+
+func hasXMLRoot(classes []models.ClassDefinition) bool {
+	for _, class := range classes {
+		if class.XMLName != "" {
+			return true
+		}
+	}
+	return false
+}
+
+func goImports(classes []models.ClassDefinition, codec bool) []string {
+	imports := []string{}
+	if codec {
+		imports = append(imports, goCodecImports...)
+	}
+	if hasXMLRoot(classes) {
+		imports = append(imports, "encoding/xml")
+	}
+	sort.Strings(imports)
+	return imports
+}
+
 var goTemplate = `
 package models
-
+{{ if eq (len .Imports) 1 }}
+import "{{ index .Imports 0 }}"
+{{ else if .Imports }}
+import (
+{{- range .Imports }}
+    "{{ . }}"
+{{- end }}
+)
+{{ end }}
 {{ range .Classes }}
+{{- if $.Docs }}{{ with classDoc . }}
+// {{ . }}
+{{- end }}{{ end }}
 type {{ .Name }} struct {
+{{- if .XMLName }}
+    XMLName xml.Name ` + "`xml:\"{{ .XMLName }}\"`" + `
+{{- end }}
 {{- range .Fields }}
-    {{ .Name }} {{ formatType . }} ` + "`json:\"{{ formatJsonTag . }}\"`" + `
+{{- if $.Docs }}{{ with fieldDoc . }}
+    // {{ . }}
+{{- end }}{{ end }}
+    {{ .Name }} {{ formatType . }} ` + "`{{ formatTags . $.Tags }}{{ if $.Validate }}{{ validateTag . }}{{ end }}`" + `
 {{- end }}
 }
 {{ end }}
-`
+{{- if .Codec }}{{ template "codec" . }}
+{{ end }}`
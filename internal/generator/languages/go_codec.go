@@ -0,0 +1,401 @@
+package languages
+
+import (
+	"github.com/jguerreno/JSON-Converter/internal/models"
+)
+
+// goCodecImports are the packages used by the codec helpers and methods
+// emitted with the "codec" option.
+var goCodecImports = []string{"bytes", "encoding/json", "fmt", "sort", "strconv"}
+
+// formatGoDecoder returns the decode function for a field, composed from
+// the helpers in goCodecTemplate so that it yields exactly formatGoType.
+func formatGoDecoder(field models.FieldDefinition) string {
+	decoder := goCodecBase("decode", field.TypeName)
+	if field.IsMap {
+		decoder = "jsonMapOf(" + decoder + ")"
+	} else if field.IsOptional {
+		decoder = "jsonOptional(" + decoder + ")"
+	}
+	if field.IsList {
+		decoder = "jsonListOf(" + decoder + ")"
+	}
+	return decoder
+}
+
+// formatGoEncoder is the encoding counterpart of formatGoDecoder.
+func formatGoEncoder(field models.FieldDefinition) string {
+	encoder := goCodecBase("append", field.TypeName)
+	if field.IsMap {
+		encoder = "jsonAppendMap(" + encoder + ")"
+	} else if field.IsOptional {
+		encoder = "jsonAppendOptional(" + encoder + ")"
+	}
+	if field.IsList {
+		encoder = "jsonAppendList(" + encoder + ")"
+	}
+	return encoder
+}
+
+func goCodecBase(prefix, typeName string) string {
+	switch typeName {
+	case "string":
+		return prefix + "JSONString"
+	case "int":
+		return prefix + "JSONInt"
+	case "int64":
+		return prefix + "JSONInt64"
+	case "float64":
+		return prefix + "JSONFloat64"
+	case "bool":
+		return prefix + "JSONBool"
+	case "interface{}":
+		return prefix + "JSONAny"
+	default:
+		return prefix + typeName
+	}
+}
+
+// formatGoOmitCheck mirrors omitempty: optional pointers are written when
+// set, optional lists and maps when they are not empty.
+func formatGoOmitCheck(field models.FieldDefinition) string {
+	if field.IsList || field.IsMap {
+		return "len(value." + field.Name + ") > 0"
+	}
+	return "value." + field.Name + " != nil"
+}
+
+var goCodecTemplate = `
+{{- define "codec" }}
+{{- range .Classes }}
+{{- $class := . }}
+
+func decode{{ .Name }}(dec *json.Decoder, tok json.Token) ({{ .Name }}, error) {
+    var value {{ .Name }}
+    if delim, ok := tok.(json.Delim); !ok || delim != '{' {
+        return value, fmt.Errorf("{{ .Name }}: expected object, got %v", tok)
+    }
+{{- range .Fields }}{{ if not .IsOptional }}
+    has{{ .Name }} := false
+{{- end }}{{ end }}
+    for dec.More() {
+        key, err := dec.Token()
+        if err != nil {
+            return value, err
+        }
+        tok, err := dec.Token()
+        if err != nil {
+            return value, err
+        }
+        switch key {
+{{- range .Fields }}
+        case {{ printf "%q" .JSONTag }}:
+            if value.{{ .Name }}, err = {{ goDecoder . }}(dec, tok); err != nil {
+                return value, fmt.Errorf("{{ $class.Name }}.{{ .JSONTag }}: %w", err)
+            }
+{{- if not .IsOptional }}
+            has{{ .Name }} = true
+{{- end }}
+{{- end }}
+        default:
+            if err := skipJSONValue(dec, tok); err != nil {
+                return value, err
+            }
+        }
+    }
+    if _, err := dec.Token(); err != nil {
+        return value, err
+    }
+{{- range .Fields }}{{ if not .IsOptional }}
+    if !has{{ .Name }} {
+        return value, fmt.Errorf("{{ $class.Name }}: missing required field %q", {{ printf "%q" .JSONTag }})
+    }
+{{- end }}{{ end }}
+    return value, nil
+}
+
+func append{{ .Name }}(buf []byte, value {{ .Name }}) ([]byte, error) {
+{{- if .Fields }}
+    var err error
+{{- end }}
+    buf = append(buf, '{')
+{{- range .Fields }}
+{{- if .IsOptional }}
+    if {{ omitCheck . }} {
+        buf = appendJSONKey(buf, {{ printf "%q" .JSONTag }})
+        if buf, err = {{ goEncoder . }}(buf, value.{{ .Name }}); err != nil {
+            return nil, err
+        }
+    }
+{{- else }}
+    buf = appendJSONKey(buf, {{ printf "%q" .JSONTag }})
+    if buf, err = {{ goEncoder . }}(buf, value.{{ .Name }}); err != nil {
+        return nil, err
+    }
+{{- end }}
+{{- end }}
+    return append(buf, '}'), nil
+}
+
+// UnmarshalJSON decodes {{ .Name }} and rejects objects missing a required field.
+func (x *{{ .Name }}) UnmarshalJSON(data []byte) error {
+    dec := newJSONDecoder(data)
+    tok, err := dec.Token()
+    if err != nil {
+        return err
+    }
+    value, err := decode{{ .Name }}(dec, tok)
+    if err != nil {
+        return err
+    }
+    *x = value
+    return nil
+}
+
+func (x {{ .Name }}) MarshalJSON() ([]byte, error) {
+    return append{{ .Name }}(nil, x)
+}
+{{- end }}
+
+// The helpers below decode from json.Decoder tokens and encode by appending
+// bytes, so the types above never go through encoding/json reflection;
+// only interface{} values of unknown shape fall back to json.Marshal.
+
+func newJSONDecoder(data []byte) *json.Decoder {
+    dec := json.NewDecoder(bytes.NewReader(data))
+    dec.UseNumber()
+    return dec
+}
+
+func decodeJSONString(dec *json.Decoder, tok json.Token) (string, error) {
+    if value, ok := tok.(string); ok {
+        return value, nil
+    }
+    return "", fmt.Errorf("expected string, got %v", tok)
+}
+
+func decodeJSONInt(dec *json.Decoder, tok json.Token) (int, error) {
+    value, err := decodeJSONInt64(dec, tok)
+    return int(value), err
+}
+
+func decodeJSONInt64(dec *json.Decoder, tok json.Token) (int64, error) {
+    if number, ok := tok.(json.Number); ok {
+        return number.Int64()
+    }
+    return 0, fmt.Errorf("expected integer, got %v", tok)
+}
+
+func decodeJSONFloat64(dec *json.Decoder, tok json.Token) (float64, error) {
+    if number, ok := tok.(json.Number); ok {
+        return number.Float64()
+    }
+    return 0, fmt.Errorf("expected number, got %v", tok)
+}
+
+func decodeJSONBool(dec *json.Decoder, tok json.Token) (bool, error) {
+    if value, ok := tok.(bool); ok {
+        return value, nil
+    }
+    return false, fmt.Errorf("expected boolean, got %v", tok)
+}
+
+func decodeJSONAny(dec *json.Decoder, tok json.Token) (interface{}, error) {
+    switch value := tok.(type) {
+    case json.Delim:
+        if value == '[' {
+            return jsonListOf(decodeJSONAny)(dec, tok)
+        }
+        return jsonMapOf(decodeJSONAny)(dec, tok)
+    case json.Number:
+        return value.Float64()
+    default:
+        return value, nil
+    }
+}
+
+func jsonOptional[T any](decode func(*json.Decoder, json.Token) (T, error)) func(*json.Decoder, json.Token) (*T, error) {
+    return func(dec *json.Decoder, tok json.Token) (*T, error) {
+        if tok == nil {
+            return nil, nil
+        }
+        value, err := decode(dec, tok)
+        if err != nil {
+            return nil, err
+        }
+        return &value, nil
+    }
+}
+
+func jsonListOf[T any](decode func(*json.Decoder, json.Token) (T, error)) func(*json.Decoder, json.Token) ([]T, error) {
+    return func(dec *json.Decoder, tok json.Token) ([]T, error) {
+        if tok == nil {
+            return nil, nil
+        }
+        if delim, ok := tok.(json.Delim); !ok || delim != '[' {
+            return nil, fmt.Errorf("expected array, got %v", tok)
+        }
+        values := []T{}
+        for dec.More() {
+            tok, err := dec.Token()
+            if err != nil {
+                return nil, err
+            }
+            value, err := decode(dec, tok)
+            if err != nil {
+                return nil, fmt.Errorf("[%d]: %w", len(values), err)
+            }
+            values = append(values, value)
+        }
+        _, err := dec.Token()
+        return values, err
+    }
+}
+
+func jsonMapOf[T any](decode func(*json.Decoder, json.Token) (T, error)) func(*json.Decoder, json.Token) (map[string]T, error) {
+    return func(dec *json.Decoder, tok json.Token) (map[string]T, error) {
+        if tok == nil {
+            return nil, nil
+        }
+        if delim, ok := tok.(json.Delim); !ok || delim != '{' {
+            return nil, fmt.Errorf("expected object, got %v", tok)
+        }
+        values := map[string]T{}
+        for dec.More() {
+            key, err := dec.Token()
+            if err != nil {
+                return nil, err
+            }
+            tok, err := dec.Token()
+            if err != nil {
+                return nil, err
+            }
+            value, err := decode(dec, tok)
+            if err != nil {
+                return nil, fmt.Errorf("[%q]: %w", key, err)
+            }
+            values[key.(string)] = value
+        }
+        _, err := dec.Token()
+        return values, err
+    }
+}
+
+func skipJSONValue(dec *json.Decoder, tok json.Token) error {
+    if _, ok := tok.(json.Delim); !ok {
+        return nil
+    }
+    for depth := 1; depth > 0; {
+        tok, err := dec.Token()
+        if err != nil {
+            return err
+        }
+        switch tok {
+        case json.Delim('{'), json.Delim('['):
+            depth++
+        case json.Delim('}'), json.Delim(']'):
+            depth--
+        }
+    }
+    return nil
+}
+
+func appendJSONKey(buf []byte, key string) []byte {
+    if buf[len(buf)-1] != '{' {
+        buf = append(buf, ',')
+    }
+    buf, _ = appendJSONString(buf, key)
+    return append(buf, ':')
+}
+
+func appendJSONString(buf []byte, value string) ([]byte, error) {
+    const hex = "0123456789abcdef"
+    buf = append(buf, '"')
+    for i := 0; i < len(value); i++ {
+        switch c := value[i]; {
+        case c == '"' || c == '\\':
+            buf = append(buf, '\\', c)
+        case c < 0x20:
+            buf = append(buf, '\\', 'u', '0', '0', hex[c>>4], hex[c&0xf])
+        default:
+            buf = append(buf, c)
+        }
+    }
+    return append(buf, '"'), nil
+}
+
+func appendJSONInt(buf []byte, value int) ([]byte, error) {
+    return strconv.AppendInt(buf, int64(value), 10), nil
+}
+
+func appendJSONInt64(buf []byte, value int64) ([]byte, error) {
+    return strconv.AppendInt(buf, value, 10), nil
+}
+
+func appendJSONFloat64(buf []byte, value float64) ([]byte, error) {
+    return strconv.AppendFloat(buf, value, 'g', -1, 64), nil
+}
+
+func appendJSONBool(buf []byte, value bool) ([]byte, error) {
+    return strconv.AppendBool(buf, value), nil
+}
+
+func appendJSONAny(buf []byte, value interface{}) ([]byte, error) {
+    data, err := json.Marshal(value)
+    if err != nil {
+        return nil, err
+    }
+    return append(buf, data...), nil
+}
+
+func jsonAppendOptional[T any](encode func([]byte, T) ([]byte, error)) func([]byte, *T) ([]byte, error) {
+    return func(buf []byte, value *T) ([]byte, error) {
+        if value == nil {
+            return append(buf, "null"...), nil
+        }
+        return encode(buf, *value)
+    }
+}
+
+func jsonAppendList[T any](encode func([]byte, T) ([]byte, error)) func([]byte, []T) ([]byte, error) {
+    return func(buf []byte, values []T) ([]byte, error) {
+        if values == nil {
+            return append(buf, "null"...), nil
+        }
+        var err error
+        buf = append(buf, '[')
+        for i, value := range values {
+            if i > 0 {
+                buf = append(buf, ',')
+            }
+            if buf, err = encode(buf, value); err != nil {
+                return nil, err
+            }
+        }
+        return append(buf, ']'), nil
+    }
+}
+
+func jsonAppendMap[T any](encode func([]byte, T) ([]byte, error)) func([]byte, map[string]T) ([]byte, error) {
+    return func(buf []byte, values map[string]T) ([]byte, error) {
+        if values == nil {
+            return append(buf, "null"...), nil
+        }
+        keys := make([]string, 0, len(values))
+        for key := range values {
+            keys = append(keys, key)
+        }
+        sort.Strings(keys)
+
+        var err error
+        buf = append(buf, '{')
+        for _, key := range keys {
+            buf = appendJSONKey(buf, key)
+            if buf, err = encode(buf, values[key]); err != nil {
+                return nil, err
+            }
+        }
+        return append(buf, '}'), nil
+    }
+}
+{{- end }}`
@@ -1,6 +1,8 @@
 package languages
 
 import (
+	"go/parser"
+	"go/token"
 	"strings"
 	"testing"
 
@@ -62,6 +64,14 @@ func TestGoFormatType(t *testing.T) {
 			},
 			want: "[]*string",
 		},
+		{
+			field: models.FieldDefinition{
+				TypeName:   "string",
+				IsOptional: true,
+				IsMap:      true,
+			},
+			want: "map[string]string",
+		},
 	}
 
 	for _, tt := range tests {
@@ -100,6 +110,36 @@ func TestGoFormatJsonTag(t *testing.T) {
 	}
 }
 
+func TestGoFormatTags(t *testing.T) {
+	tests := []struct {
+		field models.FieldDefinition
+		tags  []string
+		want  string
+	}{
+		{
+			field: models.FieldDefinition{JSONTag: "name"},
+			want:  `json:"name"`,
+		},
+		{
+			field: models.FieldDefinition{JSONTag: "name"},
+			tags:  []string{"json", "yaml"},
+			want:  `json:"name" yaml:"name"`,
+		},
+		{
+			field: models.FieldDefinition{JSONTag: "port", IsOptional: true},
+			tags:  []string{"yaml", "toml"},
+			want:  `json:"port,omitempty" yaml:"port,omitempty" toml:"port,omitempty"`,
+		},
+	}
+
+	for _, tt := range tests {
+		result := formatGoTags(tt.field, tt.tags)
+		if result != tt.want {
+			t.Errorf("formatTags(%v, %v) = %q, want %q", tt.field, tt.tags, result, tt.want)
+		}
+	}
+}
+
 func TestGenerateGo(t *testing.T) {
 	classes := []models.ClassDefinition{
 		{
@@ -146,3 +186,155 @@ func TestGenerateGo(t *testing.T) {
 	}
 
 }
+
+func TestGenerateGoXML(t *testing.T) {
+	classes := []models.ClassDefinition{
+		{
+			Name:    "Order",
+			XMLName: "order",
+			Fields: []models.FieldDefinition{
+				{Name: "Id", JSONTag: "id", TypeName: "int", XMLKind: models.XMLAttribute},
+				{Name: "Note", JSONTag: "note", TypeName: "string", IsOptional: true, XMLKind: models.XMLElement},
+				{Name: "Value", JSONTag: "value", TypeName: "string", XMLKind: models.XMLCharData},
+			},
+		},
+	}
+
+	code, err := NewGoGenerator().Generate(classes)
+	if err != nil {
+		t.Fatalf("GenerateGo failed: %v", err)
+	}
+
+	expectedCode := []string{
+		`import "encoding/xml"`,
+		"XMLName xml.Name `xml:\"order\"`",
+		"Id int `json:\"id\" xml:\"id,attr\"`",
+		"Note *string `json:\"note,omitempty\" xml:\"note,omitempty\"`",
+		"Value string `json:\"value\" xml:\",chardata\"`",
+	}
+
+	for _, expected := range expectedCode {
+		if !strings.Contains(code, expected) {
+			t.Errorf("Generated Go code missing: %q", expected)
+		}
+	}
+}
+
+func TestGenerateGoCodec(t *testing.T) {
+	classes := []models.ClassDefinition{
+		{
+			Name: "City",
+			Fields: []models.FieldDefinition{
+				{Name: "Name", JSONTag: "name", TypeName: "string"},
+			},
+		},
+		{
+			Name: "User",
+			Fields: []models.FieldDefinition{
+				{Name: "FirstName", JSONTag: "first-name", TypeName: "string"},
+				{Name: "Email", JSONTag: "email", TypeName: "string", IsOptional: true},
+				{Name: "Tags", JSONTag: "tags", TypeName: "string", IsList: true},
+				{Name: "Scores", JSONTag: "scores", TypeName: "float64", IsMap: true, IsOptional: true},
+				{Name: "Cities", JSONTag: "cities", TypeName: "City", IsList: true},
+			},
+		},
+		{Name: "Empty"},
+	}
+
+	code, err := NewGoGenerator().GenerateWithOptions(classes, models.Options{"codec": "true"})
+	if err != nil {
+		t.Fatalf("GenerateGo failed: %v", err)
+	}
+	if _, err := parser.ParseFile(token.NewFileSet(), "models.go", code, 0); err != nil {
+		t.Fatalf("generated codec is not valid Go: %v\n%s", err, code)
+	}
+
+	expectedCode := []string{
+		"\"encoding/json\"",
+		"func (x *User) UnmarshalJSON(data []byte) error {",
+		"func (x User) MarshalJSON() ([]byte, error) {",
+		`case "first-name":`,
+		"if value.FirstName, err = decodeJSONString(dec, tok); err != nil {",
+		"if value.Email, err = jsonOptional(decodeJSONString)(dec, tok); err != nil {",
+		"if value.Scores, err = jsonMapOf(decodeJSONFloat64)(dec, tok); err != nil {",
+		"if value.Cities, err = jsonListOf(decodeCity)(dec, tok); err != nil {",
+		`return value, fmt.Errorf("User: missing required field %q", "first-name")`,
+		"if value.Email != nil {",
+		"if len(value.Scores) > 0 {",
+		"if buf, err = jsonAppendList(appendCity)(buf, value.Cities); err != nil {",
+		"func skipJSONValue(dec *json.Decoder, tok json.Token) error {",
+	}
+
+	for _, expected := range expectedCode {
+		if !strings.Contains(code, expected) {
+			t.Errorf("Generated Go code missing: %q", expected)
+		}
+	}
+	if strings.Contains(code, "hasEmail") {
+		t.Error("optional fields should not be checked for presence")
+	}
+
+	plain, err := NewGoGenerator().Generate(classes)
+	if err != nil {
+		t.Fatalf("GenerateGo failed: %v", err)
+	}
+	if strings.Contains(plain, "UnmarshalJSON") || strings.Contains(plain, "import") {
+		t.Error("codec should only be generated when requested")
+	}
+}
+
+func TestFormatGoValidateTag(t *testing.T) {
+	tests := []struct {
+		field models.FieldDefinition
+		want  string
+	}{
+		{constrainedClasses[1].Fields[0], ` validate:"required,min=3,max=8"`},
+		{constrainedClasses[1].Fields[1], ` validate:"gte=1,lte=10"`},
+		{constrainedClasses[1].Fields[3], ` validate:"omitempty,max=20"`},
+		{constrainedClasses[1].Fields[4], ` validate:"required,min=1,dive"`},
+		{constrainedClasses[1].Fields[5], ""},
+		{models.FieldDefinition{TypeName: "bool"}, ""},
+	}
+
+	for _, tt := range tests {
+		result := formatGoValidateTag(tt.field)
+		if result != tt.want {
+			t.Errorf("formatGoValidateTag(%v) = %q, want %q", tt.field, result, tt.want)
+		}
+	}
+
+	code, err := NewGoGenerator().GenerateWithOptions(constrainedClasses, models.Options{"validate": "true"})
+	if err != nil {
+		t.Fatalf("GenerateGo failed: %v", err)
+	}
+	if !strings.Contains(code, "Code string `json:\"code\" validate:\"required,min=3,max=8\"`") {
+		t.Errorf("validate tag missing from generated struct:\n%s", code)
+	}
+}
+
+func TestGenerateGoDocs(t *testing.T) {
+	code, err := NewGoGenerator().GenerateWithOptions(documentedClasses, models.Options{"docs": "true"})
+	if err != nil {
+		t.Fatalf("GenerateGo failed: %v", err)
+	}
+
+	expectedCode := []string{
+		"// Path: $.users[*]\ntype UsersItem struct {",
+		"    // Example: \"alice@example.com\" (path: $.users[*].email)\n    Email string",
+		"    // Examples: 30, 41 (path: $.users[*].age)\n    Age *int",
+	}
+
+	for _, expected := range expectedCode {
+		if !strings.Contains(code, expected) {
+			t.Errorf("Generated Go code missing: %q\n%s", expected, code)
+		}
+	}
+
+	plain, err := NewGoGenerator().Generate(documentedClasses)
+	if err != nil {
+		t.Fatalf("GenerateGo failed: %v", err)
+	}
+	if strings.Contains(plain, "//") {
+		t.Errorf("doc comments should only be emitted with docs=true:\n%s", plain)
+	}
+}
@@ -0,0 +1,170 @@
+package languages
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"text/template"
+
+	"github.com/jguerreno/JSON-Converter/internal/conventions"
+	"github.com/jguerreno/JSON-Converter/internal/models"
+)
+
+var graphQLName = regexp.MustCompile(`^[_A-Za-z][_0-9A-Za-z]*$`)
+
+// GraphQLGenerator emits GraphQL SDL object types and, with the "input"
+// option, a matching input type for every class. SDL has no empty types,
+// so classes without fields are left out and referred to as JSON.
+type GraphQLGenerator struct {
+	template *template.Template
+}
+
+func NewGraphQLGenerator() *GraphQLGenerator {
+	return &GraphQLGenerator{
+		template: template.Must(template.New("graphql").Funcs(getGraphQLTemplateFuncs()).Parse(graphqlTemplate)),
+	}
+}
+
+func (g *GraphQLGenerator) GetName() string {
+	return "graphql"
+}
+
+func (g *GraphQLGenerator) GetFileExtension() string {
+	return "graphql"
+}
+
+func (g *GraphQLGenerator) TemplateFuncs() template.FuncMap {
+	return getGraphQLTemplateFuncs()
+}
+
+func (g *GraphQLGenerator) Generate(classes []models.ClassDefinition) (string, error) {
+	return g.GenerateWithOptions(classes, nil)
+}
+
+func (g *GraphQLGenerator) GenerateWithOptions(classes []models.ClassDefinition, opts models.Options) (string, error) {
+	classes = withoutEmptyGraphQLTypes(classes)
+
+	var buf strings.Builder
+	if err := g.template.Execute(&buf, map[string]interface{}{
+		"Classes":    classes,
+		"Options":    opts,
+		"Input":      opts.Bool("input"),
+		"JSONScalar": usesType(classes, isGraphQLJSONField),
+	}); err != nil {
+		return "", fmt.Errorf("failed to execute template for %s: %w", g.GetName(), err)
+	}
+
+	return buf.String(), nil
+}
+
+func convertGraphQLType(goType string) string {
+	switch goType {
+	case "string":
+		return "String"
+	case "int", "int64":
+		return "Int"
+	case "float64":
+		return "Float"
+	case "bool":
+		return "Boolean"
+	case "interface{}":
+		return "JSON"
+	default:
+		return goType
+	}
+}
+
+func getGraphQLTemplateFuncs() template.FuncMap {
+	return template.FuncMap{
+		"convertType": convertGraphQLType,
+		"formatType": func(field models.FieldDefinition) string {
+			return formatGraphQLType(field, "")
+		},
+		"formatInputType": func(field models.FieldDefinition) string {
+			return formatGraphQLType(field, "Input")
+		},
+		"fieldName": formatGraphQLFieldName,
+	}
+}
+
+// formatGraphQLType renders a field type; classSuffix is appended to class
+// references so that input types only point at other input types.
+func formatGraphQLType(field models.FieldDefinition, classSuffix string) string {
+	typeName := convertGraphQLType(field.TypeName)
+	if field.IsMap {
+		typeName = "JSON"
+	} else if !isPrimitiveType(field.TypeName) {
+		typeName += classSuffix
+	}
+
+	if field.IsList {
+		typeName = "[" + typeName + "!]"
+	}
+	if !field.IsOptional {
+		typeName += "!"
+	}
+	return typeName
+}
+
+func formatGraphQLFieldName(field models.FieldDefinition) string {
+	if graphQLName.MatchString(field.JSONTag) {
+		return field.JSONTag
+	}
+	return conventions.ToCamelCase(field.Name)
+}
+
+// withoutEmptyGraphQLTypes drops the classes without fields and turns the
+// fields referring to them into JSON ones.
+func withoutEmptyGraphQLTypes(classes []models.ClassDefinition) []models.ClassDefinition {
+	empty := map[string]bool{}
+	for _, class := range classes {
+		if len(class.Fields) == 0 {
+			empty[class.Name] = true
+		}
+	}
+	if len(empty) == 0 {
+		return classes
+	}
+
+	result := make([]models.ClassDefinition, 0, len(classes)-len(empty))
+	for _, class := range classes {
+		if empty[class.Name] {
+			continue
+		}
+		fields := make([]models.FieldDefinition, len(class.Fields))
+		for i, field := range class.Fields {
+			if empty[field.TypeName] {
+				field.TypeName = "interface{}"
+			}
+			fields[i] = field
+		}
+		class.Fields = fields
+		result = append(result, class)
+	}
+	return result
+}
+
+func isGraphQLJSONField(field models.FieldDefinition) bool {
+	return field.IsMap || field.TypeName == "interface{}"
+}
+
+var graphqlTemplate = `
+{{- if .JSONScalar }}
+scalar JSON
+{{ end }}
+{{- range .Classes }}
+type {{ .Name }} {
+{{- range .Fields }}
+  {{ fieldName . }}: {{ formatType . }}
+{{- end }}
+}
+{{ end }}
+{{- if .Input }}
+{{- range .Classes }}
+input {{ .Name }}Input {
+{{- range .Fields }}
+  {{ fieldName . }}: {{ formatInputType . }}
+{{- end }}
+}
+{{ end }}
+{{- end }}`
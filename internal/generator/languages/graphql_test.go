@@ -0,0 +1,158 @@
+package languages
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/jguerreno/JSON-Converter/internal/models"
+)
+
+func TestConvertGraphQLType(t *testing.T) {
+	tests := []struct {
+		value       string
+		graphqlType string
+	}{
+		{"string", "String"},
+		{"int", "Int"},
+		{"int64", "Int"},
+		{"float64", "Float"},
+		{"bool", "Boolean"},
+		{"MiClase", "MiClase"},
+		{"interface{}", "JSON"},
+	}
+
+	for _, tt := range tests {
+		result := convertGraphQLType(tt.value)
+		if result != tt.graphqlType {
+			t.Errorf("convertGraphQLType(%q) = %q, want %q", tt.value, result, tt.graphqlType)
+		}
+	}
+}
+
+func TestGraphQLFormatType(t *testing.T) {
+	tests := []struct {
+		field  models.FieldDefinition
+		suffix string
+		want   string
+	}{
+		{
+			field: models.FieldDefinition{TypeName: "string"},
+			want:  "String!",
+		},
+		{
+			field: models.FieldDefinition{TypeName: "string", IsOptional: true},
+			want:  "String",
+		},
+		{
+			field: models.FieldDefinition{TypeName: "int", IsList: true},
+			want:  "[Int!]!",
+		},
+		{
+			field: models.FieldDefinition{TypeName: "int", IsList: true, IsOptional: true},
+			want:  "[Int!]",
+		},
+		{
+			field: models.FieldDefinition{TypeName: "string", IsMap: true},
+			want:  "JSON!",
+		},
+		{
+			field:  models.FieldDefinition{TypeName: "City", IsList: true},
+			suffix: "Input",
+			want:   "[CityInput!]!",
+		},
+	}
+
+	for _, tt := range tests {
+		result := formatGraphQLType(tt.field, tt.suffix)
+		if result != tt.want {
+			t.Errorf("formatType(%v, %q) = %q, want %q", tt.field, tt.suffix, result, tt.want)
+		}
+	}
+}
+
+func TestGenerateGraphQL(t *testing.T) {
+	classes := []models.ClassDefinition{
+		{
+			Name: "User",
+			Fields: []models.FieldDefinition{
+				{Name: "Name", JSONTag: "name", TypeName: "string"},
+				{Name: "Age", JSONTag: "age", TypeName: "int"},
+				{Name: "Email", JSONTag: "email", TypeName: "string", IsOptional: true},
+				{Name: "Tags", JSONTag: "tags", TypeName: "string", IsList: true},
+				{Name: "City", JSONTag: "city", TypeName: "City"},
+				{Name: "AvatarUrl", JSONTag: "avatar-url", TypeName: "string"},
+				{Name: "Extra", JSONTag: "extra", TypeName: "interface{}", IsOptional: true},
+			},
+		},
+		{
+			Name: "City",
+			Fields: []models.FieldDefinition{
+				{Name: "Name", JSONTag: "name", TypeName: "string"},
+			},
+		},
+	}
+
+	code, err := NewGraphQLGenerator().Generate(classes)
+	if err != nil {
+		t.Fatalf("GenerateGraphQL failed: %v", err)
+	}
+
+	expectedCode := []string{
+		`scalar JSON`,
+		`type User {`,
+		`name: String!`,
+		`age: Int!`,
+		`email: String`,
+		`tags: [String!]!`,
+		`city: City!`,
+		`avatarUrl: String!`,
+		`extra: JSON`,
+		`type City {`,
+	}
+
+	for _, expected := range expectedCode {
+		if !strings.Contains(code, expected) {
+			t.Errorf("Generated GraphQL code missing: %q", expected)
+		}
+	}
+	if strings.Contains(code, "input ") {
+		t.Error("input types should only be generated on request")
+	}
+
+	code, err = NewGraphQLGenerator().GenerateWithOptions(classes, models.Options{"input": "true"})
+	if err != nil {
+		t.Fatalf("GenerateGraphQL failed: %v", err)
+	}
+	for _, expected := range []string{`input UserInput {`, `city: CityInput!`, `input CityInput {`} {
+		if !strings.Contains(code, expected) {
+			t.Errorf("Generated GraphQL code missing: %q", expected)
+		}
+	}
+}
+
+func TestGenerateGraphQLEmptyTypes(t *testing.T) {
+	classes := []models.ClassDefinition{
+		{
+			Name: "Event",
+			Fields: []models.FieldDefinition{
+				{Name: "Meta", JSONTag: "meta", TypeName: "Meta"},
+				{Name: "History", JSONTag: "history", TypeName: "Meta", IsList: true, IsOptional: true},
+			},
+		},
+		{Name: "Meta"},
+	}
+
+	code, err := NewGraphQLGenerator().GenerateWithOptions(classes, models.Options{"input": "true"})
+	if err != nil {
+		t.Fatalf("GenerateGraphQL failed: %v", err)
+	}
+
+	for _, expected := range []string{`scalar JSON`, `meta: JSON!`, `history: [JSON!]`} {
+		if !strings.Contains(code, expected) {
+			t.Errorf("Generated GraphQL code missing: %q\n%s", expected, code)
+		}
+	}
+	if strings.Contains(code, "Meta") {
+		t.Errorf("types without fields are not valid SDL, got:\n%s", code)
+	}
+}
@@ -2,16 +2,62 @@ package languages
 
 import (
 	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
 	"strings"
 	"text/template"
+	"unicode"
+	"unicode/utf8"
 
+	"github.com/jguerreno/JSON-Converter/internal/conventions"
 	"github.com/jguerreno/JSON-Converter/internal/models"
 )
 
+// JavaGenerator emits Java classes in the shape chosen by the "style"
+// option: pojo (default), record, lombok, lombok-value or immutables.
+// "optional" controls how optional fields are typed (nullable boxed types
+// by default, or Optional in the field or the getter), "json" picks the
+// annotation set (jackson or gson), "validate" adds Bean Validation
+// annotations and "docs" adds Javadoc with the JSON path and example
+// values of each field.
 type JavaGenerator struct {
 	template *template.Template
 }
 
+var javaStyles = map[string]bool{
+	"pojo":         true,
+	"record":       true,
+	"lombok":       true,
+	"lombok-value": true,
+	"immutables":   true,
+}
+
+var javaOptionalModes = map[string]bool{
+	"field":    true,
+	"getter":   true,
+	"nullable": true,
+}
+
+var javaJSONLibraries = map[string]bool{
+	"jackson": true,
+	"gson":    true,
+}
+
+var javaKeywords = map[string]bool{
+	"abstract": true, "assert": true, "boolean": true, "break": true, "byte": true, "case": true,
+	"catch": true, "char": true, "class": true, "const": true, "continue": true, "default": true,
+	"do": true, "double": true, "else": true, "enum": true, "extends": true, "final": true,
+	"finally": true, "float": true, "for": true, "goto": true, "if": true, "implements": true,
+	"import": true, "instanceof": true, "int": true, "interface": true, "long": true, "native": true,
+	"new": true, "package": true, "private": true, "protected": true, "public": true, "return": true,
+	"short": true, "static": true, "strictfp": true, "super": true, "switch": true, "synchronized": true,
+	"this": true, "throw": true, "throws": true, "transient": true, "try": true, "void": true,
+	"volatile": true, "while": true, "true": true, "false": true, "null": true, "record": true,
+}
+
+var javaCamelIdentifier = regexp.MustCompile(`^[a-z][A-Za-z0-9]*$`)
+
 func NewJavaGenerator() *JavaGenerator {
 	return &JavaGenerator{
 		template: template.Must(template.New("java").Funcs(getJavaTemplateFuncs()).Parse(javaTemplate)),
@@ -19,9 +65,47 @@ func NewJavaGenerator() *JavaGenerator {
 }
 
 func (j *JavaGenerator) Generate(classes []models.ClassDefinition) (string, error) {
+	return j.GenerateWithOptions(classes, nil)
+}
+
+func (j *JavaGenerator) GenerateWithOptions(classes []models.ClassDefinition, opts models.Options) (string, error) {
+	style := opts.Get("style", "pojo")
+	if !javaStyles[style] {
+		return "", fmt.Errorf("java style '%s' not supported", style)
+	}
+	optional := opts.Get("optional", "nullable")
+	if !javaOptionalModes[optional] {
+		return "", fmt.Errorf("java optional mode '%s' not supported, expected field, getter or nullable", optional)
+	}
+	jsonLibrary := opts.Get("json", "jackson")
+	if !javaJSONLibraries[jsonLibrary] {
+		return "", fmt.Errorf("java json library '%s' not supported, expected jackson or gson", jsonLibrary)
+	}
+	// Records and Immutables expose accessors only, so there is no separate
+	// getter to wrap in Optional.
+	if optional == "getter" && (style == "record" || style == "immutables") {
+		return "", fmt.Errorf("java optional mode 'getter' not supported with style '%s', which has no getters; use field or nullable", style)
+	}
+	// Gson has no adapter for Optional and cannot read it back.
+	if optional != "nullable" && jsonLibrary == "gson" {
+		return "", fmt.Errorf("java optional mode '%s' not supported with json=gson, which cannot deserialize Optional; use nullable", optional)
+	}
+
+	// JAXB binds mutable fields, which only the class based styles have.
+	hasXML := hasXMLFields(classes) && (style == "pojo" || style == "lombok" || style == "lombok-value")
+	if hasXML && optional == "field" {
+		return "", fmt.Errorf("java optional mode 'field' not supported for XML input, which JAXB cannot bind to Optional; use nullable or getter")
+	}
+
+	config := javaConfig{Style: style, Optional: optional, JSON: jsonLibrary, Validate: opts.Bool("validate"), Docs: opts.Bool("docs")}
+
 	var buf strings.Builder
 	if err := j.template.Execute(&buf, map[string]interface{}{
 		"Classes": classes,
+		"Options": opts,
+		"Config":  config,
+		"HasXML":  hasXML,
+		"Imports": javaImports(config, classes, hasXML),
 	}); err != nil {
 		return "", fmt.Errorf("failed to execute template for %s: %w", j.GetName(), err)
 	}
@@ -29,6 +113,15 @@ func (j *JavaGenerator) Generate(classes []models.ClassDefinition) (string, erro
 	return buf.String(), nil
 }
 
+// javaConfig holds the validated style options passed to the template.
+type javaConfig struct {
+	Style    string
+	Optional string
+	JSON     string
+	Validate bool
+	Docs     bool
+}
+
 func (j *JavaGenerator) GetName() string {
 	return "java"
 }
@@ -37,6 +130,10 @@ func (j *JavaGenerator) GetFileExtension() string {
 	return "java"
 }
 
+func (j *JavaGenerator) TemplateFuncs() template.FuncMap {
+	return getJavaTemplateFuncs()
+}
+
 func convertJavaType(goType string) string {
 	switch goType {
 	case "string":
@@ -58,13 +155,92 @@ func convertJavaType(goType string) string {
 
 func getJavaTemplateFuncs() template.FuncMap {
 	return template.FuncMap{
-		"convertType": formatJavaType,
+		"convertType": convertJavaType,
+		"formatType": func(field models.FieldDefinition) string {
+			return formatJavaFieldType(javaConfig{Optional: "nullable"}, field)
+		},
 		"jsonTag": func(field models.FieldDefinition) string {
 			return field.JSONTag
 		},
+		"fieldName":      formatJavaFieldName,
+		"getterName":     formatJavaGetterName,
+		"fieldType":      formatJavaFieldType,
+		"getterType":     formatJavaGetterType,
+		"jsonAnnotation": formatJavaJSONAnnotation,
+		"constraints":    formatJavaConstraints,
+		"wrapsOptional": func(config javaConfig, field models.FieldDefinition) bool {
+			return config.Optional == "getter" && field.IsOptional
+		},
+		"xmlAnnotation": formatJavaXMLAnnotation,
+		"hasXMLFields": func(class models.ClassDefinition) bool {
+			return hasXMLFields([]models.ClassDefinition{class})
+		},
+		"classDoc": formatJavaClassDoc,
+		"fieldDoc": formatJavaFieldDoc,
 	}
 }
 
+// formatJavaClassDoc returns the Javadoc for a class. Record components
+// cannot carry their own Javadoc, so for records the field docs become
+// @param tags here.
+func formatJavaClassDoc(config javaConfig, class models.ClassDefinition) string {
+	if !config.Docs {
+		return ""
+	}
+	lines := []string{}
+	if doc := formatClassDoc(class); doc != "" {
+		lines = append(lines, doc)
+	}
+	if config.Style == "record" {
+		for _, field := range class.Fields {
+			if doc := formatFieldDoc(field); doc != "" {
+				lines = append(lines, "@param "+formatJavaFieldName(field)+" "+doc)
+			}
+		}
+	}
+	if len(lines) == 0 {
+		return ""
+	}
+	if len(lines) == 1 {
+		return "/** " + formatBlockDoc(lines[0]) + " */"
+	}
+	return "/**\n * " + formatBlockDoc(strings.Join(lines, "\n * ")) + "\n */"
+}
+
+func formatJavaFieldDoc(config javaConfig, field models.FieldDefinition) string {
+	doc := formatFieldDoc(field)
+	if !config.Docs || config.Style == "record" || doc == "" {
+		return ""
+	}
+	return "/** " + formatBlockDoc(doc) + " */"
+}
+
+// formatJavaXMLAnnotation returns the JAXB annotation binding a field to
+// its XML attribute, element or text content.
+func formatJavaXMLAnnotation(field models.FieldDefinition) string {
+	switch field.XMLKind {
+	case models.XMLAttribute:
+		return fmt.Sprintf("@XmlAttribute(name = %q)", field.JSONTag)
+	case models.XMLElement:
+		return fmt.Sprintf("@XmlElement(name = %q)", field.JSONTag)
+	case models.XMLCharData:
+		return "@XmlValue"
+	default:
+		return ""
+	}
+}
+
+func hasXMLFields(classes []models.ClassDefinition) bool {
+	for _, class := range classes {
+		for _, field := range class.Fields {
+			if field.XMLKind != "" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func formatJavaType(field models.FieldDefinition) string {
 	typeBuilder := strings.Builder{}
 	if field.IsOptional {
@@ -73,7 +249,13 @@ func formatJavaType(field models.FieldDefinition) string {
 	if field.IsList {
 		typeBuilder.WriteString("List<")
 	}
+	if field.IsMap {
+		typeBuilder.WriteString("Map<String, ")
+	}
 	typeBuilder.WriteString(convertJavaType(field.TypeName))
+	if field.IsMap {
+		typeBuilder.WriteString(">")
+	}
 	if field.IsList {
 		typeBuilder.WriteString(">")
 	}
@@ -83,28 +265,287 @@ func formatJavaType(field models.FieldDefinition) string {
 	return typeBuilder.String()
 }
 
-var javaTemplate = `
-import com.fasterxml.jackson.annotation.JsonProperty
-import java.util.List
-import java.util.Optional
+// formatJavaFieldName keeps JSON keys that are already camelCase and
+// converts the rest, escaping Java keywords with a trailing underscore.
+func formatJavaFieldName(field models.FieldDefinition) string {
+	name := field.JSONTag
+	if !javaCamelIdentifier.MatchString(name) {
+		name = conventions.ToCamelCase(field.Name)
+	}
+	if name == "" || !javaIdentifierStart(name) {
+		name = "_" + name
+	}
+	if javaKeywords[name] {
+		name += "_"
+	}
+	return name
+}
+
+func javaIdentifierStart(name string) bool {
+	r, _ := utf8.DecodeRuneInString(name)
+	return unicode.IsLetter(r) || r == '_' || r == '$'
+}
+
+func formatJavaGetterName(field models.FieldDefinition) string {
+	name := formatJavaFieldName(field)
+	r, size := utf8.DecodeRuneInString(name)
+	return "get" + string(unicode.ToUpper(r)) + name[size:]
+}
+
+// formatJavaFieldType is the declared type of the field or component; only
+// the "field" mode stores Optional.
+func formatJavaFieldType(config javaConfig, field models.FieldDefinition) string {
+	if config.Optional != "field" {
+		field.IsOptional = false
+	}
+	return formatJavaType(field)
+}
+
+// formatJavaGetterType is the getter's return type; "getter" mode wraps
+// the nullable field in Optional there instead.
+func formatJavaGetterType(config javaConfig, field models.FieldDefinition) string {
+	if config.Optional == "nullable" {
+		field.IsOptional = false
+	}
+	return formatJavaType(field)
+}
+
+func formatJavaJSONAnnotation(config javaConfig, field models.FieldDefinition) string {
+	if config.JSON == "gson" {
+		return fmt.Sprintf("@SerializedName(%q)", field.JSONTag)
+	}
+	return fmt.Sprintf("@JsonProperty(%q)", field.JSONTag)
+}
+
+// formatJavaConstraints returns the Bean Validation annotations for a
+// field. Fields typed as Optional get none, since the constraints would
+// apply to the wrapper rather than the value.
+func formatJavaConstraints(config javaConfig, field models.FieldDefinition) []string {
+	if !config.Validate || (field.IsOptional && config.Optional == "field") {
+		return nil
+	}
+
+	annotations := []string{}
+	if !field.IsOptional {
+		annotations = append(annotations, "@NotNull")
+	}
+
+	constraints := field.Constraints
+	if constraints == nil {
+		constraints = &models.Constraints{}
+	}
+	switch {
+	case field.IsList:
+		annotations = appendJavaSize(annotations, constraints.MinItems, constraints.MaxItems)
+	case field.IsMap:
+	case field.TypeName == "string":
+		annotations = appendJavaSize(annotations, constraints.MinLength, constraints.MaxLength)
+	case field.TypeName == "int" || field.TypeName == "int64":
+		if constraints.Minimum != nil {
+			annotations = append(annotations, fmt.Sprintf("@Min(%d)", int64(*constraints.Minimum)))
+		}
+		if constraints.Maximum != nil {
+			annotations = append(annotations, fmt.Sprintf("@Max(%d)", int64(*constraints.Maximum)))
+		}
+	case field.TypeName == "float64":
+		if constraints.Minimum != nil {
+			annotations = append(annotations, fmt.Sprintf("@DecimalMin(%q)", strconv.FormatFloat(*constraints.Minimum, 'f', -1, 64)))
+		}
+		if constraints.Maximum != nil {
+			annotations = append(annotations, fmt.Sprintf("@DecimalMax(%q)", strconv.FormatFloat(*constraints.Maximum, 'f', -1, 64)))
+		}
+	}
+
+	if !isPrimitiveType(field.TypeName) {
+		annotations = append(annotations, "@Valid")
+	}
+	return annotations
+}
+
+func appendJavaSize(annotations []string, minimum, maximum *int) []string {
+	bounds := []string{}
+	if minimum != nil && *minimum > 0 {
+		bounds = append(bounds, fmt.Sprintf("min = %d", *minimum))
+	}
+	if maximum != nil {
+		bounds = append(bounds, fmt.Sprintf("max = %d", *maximum))
+	}
+	if len(bounds) == 0 {
+		return annotations
+	}
+	return append(annotations, "@Size("+strings.Join(bounds, ", ")+")")
+}
+
+func javaImports(config javaConfig, classes []models.ClassDefinition, hasXML bool) []string {
+	imports := []string{}
+	add := func(names ...string) {
+		imports = append(imports, names...)
+	}
+
+	if config.JSON == "gson" {
+		add("com.google.gson.annotations.SerializedName")
+		if config.Style == "immutables" {
+			add("org.immutables.gson.Gson")
+		}
+	} else {
+		add("com.fasterxml.jackson.annotation.JsonProperty")
+		if config.Style == "immutables" {
+			add("com.fasterxml.jackson.databind.annotation.JsonDeserialize",
+				"com.fasterxml.jackson.databind.annotation.JsonSerialize")
+		}
+		if config.Style == "lombok-value" {
+			add("lombok.extern.jackson.Jacksonized")
+		}
+	}
+
+	if usesType(classes, func(field models.FieldDefinition) bool { return field.IsList }) {
+		add("java.util.List")
+	}
+	if usesType(classes, func(field models.FieldDefinition) bool { return field.IsMap }) {
+		add("java.util.Map")
+	}
+	hasOptional := usesType(classes, func(field models.FieldDefinition) bool { return field.IsOptional })
+	if hasOptional && config.Optional != "nullable" {
+		add("java.util.Optional")
+	}
+
+	switch config.Style {
+	case "lombok":
+		add("lombok.AllArgsConstructor", "lombok.Builder", "lombok.Data", "lombok.NoArgsConstructor")
+	case "lombok-value":
+		add("lombok.Builder", "lombok.Value")
+	case "immutables":
+		add("org.immutables.value.Value")
+		if hasOptional && config.Optional == "nullable" {
+			add("jakarta.annotation.Nullable")
+		}
+	}
+
+	validation := make(map[string]bool)
+	for _, class := range classes {
+		for _, field := range class.Fields {
+			for _, annotation := range formatJavaConstraints(config, field) {
+				name := strings.TrimPrefix(strings.SplitN(annotation, "(", 2)[0], "@")
+				validation[name] = true
+			}
+		}
+	}
+	for name := range validation {
+		if name == "Valid" {
+			add("jakarta.validation.Valid")
+		} else {
+			add("jakarta.validation.constraints." + name)
+		}
+	}
+
+	if hasXML {
+		add("jakarta.xml.bind.annotation.XmlAccessType",
+			"jakarta.xml.bind.annotation.XmlAccessorType",
+			"jakarta.xml.bind.annotation.XmlAttribute",
+			"jakarta.xml.bind.annotation.XmlElement",
+			"jakarta.xml.bind.annotation.XmlRootElement",
+			"jakarta.xml.bind.annotation.XmlValue")
+	}
 
+	sort.Strings(imports)
+	return imports
+}
+
+var javaTemplate = `
+{{- range .Imports }}
+import {{ . }};
+{{- end }}
 {{ range .Classes }}
+{{- with classDoc $.Config . }}
+{{ . }}
+{{- end }}
+{{- if eq $.Config.Style "record" }}
+public record {{ .Name }}(
+{{- range $index, $field := .Fields }}{{ if $index }},{{ end }}
+    {{ jsonAnnotation $.Config . }}{{ range constraints $.Config . }} {{ . }}{{ end }} {{ fieldType $.Config . }} {{ fieldName . }}
+{{- end }}
+) {}
+{{- else if eq $.Config.Style "immutables" }}
+@Value.Immutable
+{{- if eq $.Config.JSON "gson" }}
+@Gson.TypeAdapters
+{{- else }}
+@JsonSerialize(as = Immutable{{ .Name }}.class)
+@JsonDeserialize(as = Immutable{{ .Name }}.class)
+{{- end }}
+public interface {{ .Name }} {
+{{- range .Fields }}
+{{- with fieldDoc $.Config . }}
+    {{ . }}
+{{- end }}
+    {{ jsonAnnotation $.Config . }}
+{{- range constraints $.Config . }}
+    {{ . }}
+{{- end }}
+{{- if and .IsOptional (eq $.Config.Optional "nullable") }}
+    @Nullable
+{{- end }}
+    {{ fieldType $.Config . }} {{ fieldName . }}();
+{{- end }}
+}
+{{- else }}
+{{- if .XMLName }}
+@XmlRootElement(name = "{{.XMLName}}")
+{{- end }}
+{{- if and $.HasXML (hasXMLFields .) }}
+@XmlAccessorType(XmlAccessType.FIELD)
+{{- end }}
+{{- if eq $.Config.Style "lombok" }}
+@Data
+@Builder
+@NoArgsConstructor
+@AllArgsConstructor
+{{- else if eq $.Config.Style "lombok-value" }}
+@Value
+@Builder
+{{- if eq $.Config.JSON "jackson" }}
+@Jacksonized
+{{- end }}
+{{- end }}
 public class {{.Name}} {
 {{- range .Fields }}
-    @JsonProperty("{{.JSONTag}}")
-    private {{convertType .}} {{.JSONTag}};
+{{- with fieldDoc $.Config . }}
+    {{ . }}
+{{- end }}
+{{- if $.HasXML }}
+{{- with xmlAnnotation . }}
+    {{ . }}
+{{- end }}
+{{- end }}
+    {{ jsonAnnotation $.Config . }}
+{{- range constraints $.Config . }}
+    {{ . }}
 {{- end }}
+    {{ if ne $.Config.Style "lombok-value" }}private {{ end }}{{ fieldType $.Config . }} {{ fieldName . }};
+{{- end }}
+{{- if eq $.Config.Style "pojo" }}
 
     public {{.Name}}() {}
-
 {{- range .Fields }}
-    public {{convertType .}} get{{.Name}}() {
-        return {{.JSONTag}};
+
+    public {{ getterType $.Config . }} {{ getterName . }}() {
+        return {{ if wrapsOptional $.Config . }}Optional.ofNullable({{ fieldName . }}){{ else }}{{ fieldName . }}{{ end }};
     }
-    
-    public void set{{.Name}}({{convertType .}} {{.JSONTag}}) {
-        this.{{.JSONTag}} = {{.JSONTag}};
+
+    public void set{{ slice (getterName .) 3 }}({{ fieldType $.Config . }} {{ fieldName . }}) {
+        this.{{ fieldName . }} = {{ fieldName . }};
     }
 {{- end }}
+{{- else }}
+{{- range .Fields }}
+{{- if wrapsOptional $.Config . }}
+
+    public {{ getterType $.Config . }} {{ getterName . }}() {
+        return Optional.ofNullable({{ fieldName . }});
+    }
+{{- end }}
+{{- end }}
+{{- end }}
 }
+{{- end }}
 {{ end }}`
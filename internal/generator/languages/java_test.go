@@ -101,14 +101,13 @@ func TestGenerateJava(t *testing.T) {
 	expectedCode := []string{
 		`import com.fasterxml.jackson.annotation.JsonProperty`,
 		`import java.util.List`,
-		`import java.util.Optional`,
 		`public class User`,
 		`@JsonProperty("name")`,
 		`private String name`,
 		`@JsonProperty("age")`,
 		`private Integer age`,
 		`@JsonProperty("email")`,
-		`private Optional<String> email`,
+		`private String email`,
 		`@JsonProperty("tags")`,
 		`private List<String> tags`,
 		`@JsonProperty("city")`,
@@ -126,3 +125,255 @@ func TestGenerateJava(t *testing.T) {
 		}
 	}
 }
+
+func TestGenerateJavaXML(t *testing.T) {
+	classes := []models.ClassDefinition{
+		{
+			Name:    "Order",
+			XMLName: "order",
+			Fields: []models.FieldDefinition{
+				{Name: "Id", JSONTag: "id", TypeName: "int", XMLKind: models.XMLAttribute},
+				{Name: "Note", JSONTag: "note", TypeName: "string", XMLKind: models.XMLElement},
+				{Name: "Value", JSONTag: "value", TypeName: "string", XMLKind: models.XMLCharData},
+				{Name: "Count", JSONTag: "count", TypeName: "int", IsOptional: true, XMLKind: models.XMLElement},
+			},
+		},
+	}
+
+	code, err := NewJavaGenerator().Generate(classes)
+	if err != nil {
+		t.Fatalf("GenerateJava failed: %v", err)
+	}
+
+	expectedCode := []string{
+		`import jakarta.xml.bind.annotation.XmlRootElement;`,
+		`@XmlRootElement(name = "order")`,
+		`@XmlAccessorType(XmlAccessType.FIELD)`,
+		`@XmlAttribute(name = "id")`,
+		`@XmlElement(name = "note")`,
+		`@XmlValue`,
+		"@XmlElement(name = \"count\")\n    @JsonProperty(\"count\")\n    private Integer count;",
+	}
+
+	for _, expected := range expectedCode {
+		if !strings.Contains(code, expected) {
+			t.Errorf("Generated Java code missing: %q", expected)
+		}
+	}
+
+	// JAXB cannot bind Optional fields.
+	if _, err := NewJavaGenerator().GenerateWithOptions(classes, models.Options{"optional": "field"}); err == nil {
+		t.Error("expected error for optional=field with XML input")
+	}
+
+	plain, err := NewJavaGenerator().Generate([]models.ClassDefinition{{Name: "Plain"}})
+	if err != nil {
+		t.Fatalf("GenerateJava failed: %v", err)
+	}
+	if strings.Contains(plain, "jakarta.xml.bind") {
+		t.Error("JAXB imports should only be emitted for XML models")
+	}
+}
+
+var javaStyleClasses = []models.ClassDefinition{
+	{
+		Name: "User",
+		Fields: []models.FieldDefinition{
+			{Name: "FirstName", JSONTag: "first_name", TypeName: "string"},
+			{Name: "Email", JSONTag: "email", TypeName: "string", IsOptional: true},
+			{Name: "Tags", JSONTag: "tags", TypeName: "string", IsList: true},
+		},
+	},
+}
+
+func TestFormatJavaFieldName(t *testing.T) {
+	tests := []struct {
+		field models.FieldDefinition
+		want  string
+	}{
+		{models.FieldDefinition{Name: "Firstname", JSONTag: "firstName"}, "firstName"},
+		{models.FieldDefinition{Name: "FirstName", JSONTag: "first_name"}, "firstName"},
+		{models.FieldDefinition{Name: "ContentType", JSONTag: "Content-Type"}, "contentType"},
+		{models.FieldDefinition{Name: "Class", JSONTag: "class"}, "class_"},
+		{models.FieldDefinition{Name: "2fa", JSONTag: "2fa"}, "_2fa"},
+	}
+
+	for _, tt := range tests {
+		result := formatJavaFieldName(tt.field)
+		if result != tt.want {
+			t.Errorf("formatJavaFieldName(%v) = %q, want %q", tt.field, result, tt.want)
+		}
+	}
+}
+
+func TestGenerateJavaStyles(t *testing.T) {
+	tests := []struct {
+		name     string
+		opts     models.Options
+		expected []string
+		missing  []string
+	}{
+		{
+			name: "pojo with optional getters",
+			opts: models.Options{"optional": "getter"},
+			expected: []string{
+				"import java.util.Optional;",
+				`@JsonProperty("first_name")`,
+				"private String firstName;",
+				"private String email;",
+				"public Optional<String> getEmail() {\n        return Optional.ofNullable(email);",
+				"public void setEmail(String email) {",
+				"public String getFirstName() {",
+			},
+		},
+		{
+			name: "record",
+			opts: models.Options{"style": "record", "optional": "field"},
+			expected: []string{
+				"public record User(",
+				`    @JsonProperty("first_name") String firstName,`,
+				`    @JsonProperty("email") Optional<String> email,`,
+				`    @JsonProperty("tags") List<String> tags`,
+				") {}",
+			},
+		},
+		{
+			name: "lombok with gson",
+			opts: models.Options{"style": "lombok", "json": "gson", "optional": "nullable"},
+			expected: []string{
+				"import com.google.gson.annotations.SerializedName;",
+				"import lombok.Data;",
+				"@Data\n@Builder\n@NoArgsConstructor\n@AllArgsConstructor\npublic class User {",
+				`@SerializedName("first_name")`,
+				"private String email;",
+			},
+			missing: []string{"JsonProperty", "java.util.Optional", "getEmail"},
+		},
+		{
+			name: "lombok value",
+			opts: models.Options{"style": "lombok-value", "optional": "field"},
+			expected: []string{
+				"@Value\n@Builder\n@Jacksonized\npublic class User {",
+				"    Optional<String> email;",
+			},
+		},
+		{
+			name: "immutables",
+			opts: models.Options{"style": "immutables", "optional": "nullable"},
+			expected: []string{
+				"import org.immutables.value.Value;",
+				"import jakarta.annotation.Nullable;",
+				"@Value.Immutable",
+				"@JsonDeserialize(as = ImmutableUser.class)",
+				"public interface User {",
+				"    @Nullable\n    String email();",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, err := NewJavaGenerator().GenerateWithOptions(javaStyleClasses, tt.opts)
+			if err != nil {
+				t.Fatalf("GenerateJava failed: %v", err)
+			}
+			for _, expected := range tt.expected {
+				if !strings.Contains(code, expected) {
+					t.Errorf("Generated Java code missing: %q\n%s", expected, code)
+				}
+			}
+			for _, unexpected := range tt.missing {
+				if strings.Contains(code, unexpected) {
+					t.Errorf("Generated Java code should not contain %q\n%s", unexpected, code)
+				}
+			}
+		})
+	}
+}
+
+func TestGenerateJavaInvalidOptions(t *testing.T) {
+	for _, opts := range []models.Options{
+		{"style": "bean"},
+		{"optional": "always"},
+		{"json": "moshi"},
+		{"style": "record", "optional": "getter"},
+		{"style": "immutables", "optional": "getter"},
+		{"json": "gson", "optional": "field"},
+	} {
+		if _, err := NewJavaGenerator().GenerateWithOptions(javaStyleClasses, opts); err == nil {
+			t.Errorf("expected error for options %v", opts)
+		}
+	}
+}
+
+func TestGenerateJavaValidation(t *testing.T) {
+	code, err := NewJavaGenerator().GenerateWithOptions(constrainedClasses, models.Options{"validate": "true", "optional": "nullable"})
+	if err != nil {
+		t.Fatalf("GenerateJava failed: %v", err)
+	}
+
+	expectedCode := []string{
+		"import jakarta.validation.Valid;",
+		"import jakarta.validation.constraints.NotNull;",
+		"import jakarta.validation.constraints.Size;",
+		"    @NotNull\n    @Size(min = 3, max = 8)\n    private String code;",
+		"    @NotNull\n    @Min(1)\n    @Max(10)\n    private Integer qty;",
+		"    @DecimalMin(\"0.5\")",
+		"    @Size(max = 20)\n    private String note;",
+		"    @NotNull\n    @Size(min = 1)\n    @Valid\n    private List<Line> lines;",
+	}
+
+	for _, expected := range expectedCode {
+		if !strings.Contains(code, expected) {
+			t.Errorf("Generated Java code missing: %q\n%s", expected, code)
+		}
+	}
+
+	plain, err := NewJavaGenerator().Generate(constrainedClasses)
+	if err != nil {
+		t.Fatalf("GenerateJava failed: %v", err)
+	}
+	if strings.Contains(plain, "jakarta.validation") {
+		t.Error("Bean Validation should only be emitted with validate=true")
+	}
+}
+
+func TestGenerateJavaDocs(t *testing.T) {
+	tests := []struct {
+		style        string
+		expectedCode []string
+	}{
+		{
+			style: "pojo",
+			expectedCode: []string{
+				"/** Path: $.users[*] */\npublic class UsersItem {",
+				"    /** Example: \"alice@example.com\" (path: $.users[*].email) */\n    @JsonProperty(\"email\")",
+			},
+		},
+		{
+			style: "record",
+			expectedCode: []string{
+				"/**\n * Path: $.users[*]\n * @param email Example: \"alice@example.com\" (path: $.users[*].email)\n * @param age Examples: 30, 41 (path: $.users[*].age)\n */\npublic record UsersItem(",
+			},
+		},
+		{
+			style: "immutables",
+			expectedCode: []string{
+				"/** Path: $.users[*] */\n@Value.Immutable",
+				"    /** Examples: 30, 41 (path: $.users[*].age) */\n    @JsonProperty(\"age\")",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		code, err := NewJavaGenerator().GenerateWithOptions(documentedClasses, models.Options{"style": tt.style, "docs": "true"})
+		if err != nil {
+			t.Fatalf("GenerateJava(%s) failed: %v", tt.style, err)
+		}
+		for _, expected := range tt.expectedCode {
+			if !strings.Contains(code, expected) {
+				t.Errorf("Generated Java %s code missing: %q\n%s", tt.style, expected, code)
+			}
+		}
+	}
+}
@@ -0,0 +1,151 @@
+package languages
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/jguerreno/JSON-Converter/internal/models"
+)
+
+const jsonSchemaDialect = "https://json-schema.org/draft/2020-12/schema"
+
+// JSONSchemaGenerator emits a JSON Schema (draft 2020-12) document. The
+// root class is the document itself and every other class is placed under
+// $defs. With the "validate" option the observed constraints are added as
+// minLength, maximum, minItems and similar keywords.
+type JSONSchemaGenerator struct{}
+
+type jsonSchema struct {
+	Schema               string                 `json:"$schema,omitempty"`
+	Ref                  string                 `json:"$ref,omitempty"`
+	Title                string                 `json:"title,omitempty"`
+	Type                 interface{}            `json:"type,omitempty"`
+	Format               string                 `json:"format,omitempty"`
+	Enum                 []string               `json:"enum,omitempty"`
+	MinLength            *int                   `json:"minLength,omitempty"`
+	MaxLength            *int                   `json:"maxLength,omitempty"`
+	Minimum              *float64               `json:"minimum,omitempty"`
+	Maximum              *float64               `json:"maximum,omitempty"`
+	Items                *jsonSchema            `json:"items,omitempty"`
+	MinItems             *int                   `json:"minItems,omitempty"`
+	MaxItems             *int                   `json:"maxItems,omitempty"`
+	Properties           map[string]*jsonSchema `json:"properties,omitempty"`
+	Required             []string               `json:"required,omitempty"`
+	AdditionalProperties *jsonSchema            `json:"additionalProperties,omitempty"`
+	AnyOf                []*jsonSchema          `json:"anyOf,omitempty"`
+	Defs                 map[string]*jsonSchema `json:"$defs,omitempty"`
+}
+
+func NewJSONSchemaGenerator() *JSONSchemaGenerator {
+	return &JSONSchemaGenerator{}
+}
+
+func (j *JSONSchemaGenerator) GetName() string {
+	return "jsonschema"
+}
+
+func (j *JSONSchemaGenerator) GetFileExtension() string {
+	return "schema.json"
+}
+
+func (j *JSONSchemaGenerator) Generate(classes []models.ClassDefinition) (string, error) {
+	return j.GenerateWithOptions(classes, nil)
+}
+
+func (j *JSONSchemaGenerator) GenerateWithOptions(classes []models.ClassDefinition, opts models.Options) (string, error) {
+	validate := opts.Bool("validate")
+	roots := rootClasses(classes)
+
+	document := &jsonSchema{}
+	if len(roots) == 1 {
+		document = objectSchema(roots[0], validate)
+	} else {
+		for _, root := range roots {
+			document.AnyOf = append(document.AnyOf, &jsonSchema{Ref: "#/$defs/" + root.Name})
+		}
+	}
+	document.Schema = jsonSchemaDialect
+
+	for _, class := range classes {
+		if len(roots) == 1 && class.Name == roots[0].Name {
+			continue
+		}
+		if document.Defs == nil {
+			document.Defs = make(map[string]*jsonSchema)
+		}
+		document.Defs[class.Name] = objectSchema(class, validate)
+	}
+
+	output, err := json.MarshalIndent(document, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to encode schema for %s: %w", j.GetName(), err)
+	}
+	return string(output) + "\n", nil
+}
+
+func objectSchema(class models.ClassDefinition, validate bool) *jsonSchema {
+	schema := &jsonSchema{
+		Title:      class.Name,
+		Type:       "object",
+		Properties: make(map[string]*jsonSchema),
+	}
+	for _, field := range class.Fields {
+		schema.Properties[field.JSONTag] = fieldSchema(field, validate)
+		if !field.IsOptional {
+			schema.Required = append(schema.Required, field.JSONTag)
+		}
+	}
+	return schema
+}
+
+// fieldSchema describes a field's value. Optional fields also accept null,
+// since the parser marks a field optional when it is missing or null.
+func fieldSchema(field models.FieldDefinition, validate bool) *jsonSchema {
+	schema := valueSchema(field)
+	constraints := field.Constraints
+	if !validate || constraints == nil {
+		constraints = &models.Constraints{}
+	}
+
+	switch {
+	case field.IsList:
+		if field.IsMap {
+			schema = &jsonSchema{Type: "object", AdditionalProperties: schema}
+		}
+		schema = &jsonSchema{Type: "array", Items: schema, MinItems: constraints.MinItems, MaxItems: constraints.MaxItems}
+	case field.IsMap:
+		schema = &jsonSchema{Type: "object", AdditionalProperties: schema}
+	case field.TypeName == "string":
+		schema.MinLength, schema.MaxLength = constraints.MinLength, constraints.MaxLength
+	default:
+		schema.Minimum, schema.Maximum = constraints.Minimum, constraints.Maximum
+	}
+
+	if !field.IsOptional {
+		return schema
+	}
+	if typeName, ok := schema.Type.(string); ok {
+		schema.Type = []string{typeName, "null"}
+		return schema
+	}
+	return &jsonSchema{AnyOf: []*jsonSchema{schema, {Type: "null"}}}
+}
+
+func valueSchema(field models.FieldDefinition) *jsonSchema {
+	switch field.TypeName {
+	case "string":
+		return &jsonSchema{Type: "string", Format: field.Format, Enum: field.Enum}
+	case "int":
+		return &jsonSchema{Type: "integer"}
+	case "int64":
+		return &jsonSchema{Type: "integer", Format: "int64"}
+	case "float64":
+		return &jsonSchema{Type: "number"}
+	case "bool":
+		return &jsonSchema{Type: "boolean"}
+	case "interface{}":
+		return &jsonSchema{}
+	default:
+		return &jsonSchema{Ref: "#/$defs/" + field.TypeName}
+	}
+}
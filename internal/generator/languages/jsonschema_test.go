@@ -0,0 +1,75 @@
+package languages
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/jguerreno/JSON-Converter/internal/models"
+)
+
+func intBound(n int) *int { return &n }
+
+func floatBound(f float64) *float64 { return &f }
+
+var constrainedClasses = []models.ClassDefinition{
+	{
+		Name: "Line",
+		Fields: []models.FieldDefinition{
+			{Name: "Sku", JSONTag: "sku", TypeName: "string"},
+		},
+	},
+	{
+		Name: "Order",
+		Fields: []models.FieldDefinition{
+			{Name: "Code", JSONTag: "code", TypeName: "string", Constraints: &models.Constraints{MinLength: intBound(3), MaxLength: intBound(8)}},
+			{Name: "Qty", JSONTag: "qty", TypeName: "int", Constraints: &models.Constraints{Minimum: floatBound(1), Maximum: floatBound(10)}},
+			{Name: "Price", JSONTag: "price", TypeName: "float64", Constraints: &models.Constraints{Minimum: floatBound(0.5)}},
+			{Name: "Note", JSONTag: "note", TypeName: "string", IsOptional: true, Constraints: &models.Constraints{MaxLength: intBound(20)}},
+			{Name: "Lines", JSONTag: "lines", TypeName: "Line", IsList: true, Constraints: &models.Constraints{MinItems: intBound(1)}},
+			{Name: "Billing", JSONTag: "billing", TypeName: "Line", IsOptional: true},
+		},
+	},
+}
+
+func TestGenerateJSONSchema(t *testing.T) {
+	code, err := NewJSONSchemaGenerator().GenerateWithOptions(constrainedClasses, models.Options{"validate": "true"})
+	if err != nil {
+		t.Fatalf("GenerateJSONSchema failed: %v", err)
+	}
+
+	var document map[string]interface{}
+	if err := json.Unmarshal([]byte(code), &document); err != nil {
+		t.Fatalf("generated schema is not valid JSON: %v", err)
+	}
+	if document["title"] != "Order" || document["$schema"] != jsonSchemaDialect {
+		t.Errorf("root schema should describe Order, got %v", document["title"])
+	}
+
+	expectedCode := []string{
+		`"minLength": 3,`,
+		`"maxLength": 8`,
+		`"minimum": 1,`,
+		`"maximum": 10`,
+		`"minimum": 0.5`,
+		"\"type\": [\n        \"string\",\n        \"null\"\n      ],\n      \"maxLength\": 20",
+		`"$ref": "#/$defs/Line"`,
+		`"minItems": 1`,
+		"\"anyOf\": [\n        {\n          \"$ref\": \"#/$defs/Line\"\n        },\n        {\n          \"type\": \"null\"",
+		"\"required\": [\n    \"code\",\n    \"qty\",\n    \"price\",\n    \"lines\"\n  ]",
+	}
+
+	for _, expected := range expectedCode {
+		if !strings.Contains(code, expected) {
+			t.Errorf("Generated JSON Schema missing: %q", expected)
+		}
+	}
+
+	plain, err := NewJSONSchemaGenerator().Generate(constrainedClasses)
+	if err != nil {
+		t.Fatalf("GenerateJSONSchema failed: %v", err)
+	}
+	if strings.Contains(plain, "minLength") {
+		t.Error("constraint keywords should only be emitted with validate=true")
+	}
+}
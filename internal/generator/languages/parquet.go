@@ -0,0 +1,134 @@
+package languages
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jguerreno/JSON-Converter/internal/models"
+)
+
+// ParquetGenerator emits the Parquet message schema for each root class,
+// using the standard LIST and MAP layouts so that Apache Arrow maps it to
+// the matching list, map and struct types.
+type ParquetGenerator struct{}
+
+type parquetBuilder struct {
+	classes  map[string]models.ClassDefinition
+	visiting map[string]bool
+	out      strings.Builder
+}
+
+func NewParquetGenerator() *ParquetGenerator {
+	return &ParquetGenerator{}
+}
+
+func (p *ParquetGenerator) GetName() string {
+	return "parquet"
+}
+
+func (p *ParquetGenerator) GetFileExtension() string {
+	return "schema"
+}
+
+func (p *ParquetGenerator) Generate(classes []models.ClassDefinition) (string, error) {
+	return p.GenerateWithOptions(classes, nil)
+}
+
+func (p *ParquetGenerator) GenerateWithOptions(classes []models.ClassDefinition, opts models.Options) (string, error) {
+	builder := &parquetBuilder{
+		classes:  make(map[string]models.ClassDefinition),
+		visiting: make(map[string]bool),
+	}
+	for _, class := range classes {
+		builder.classes[class.Name] = class
+	}
+
+	for i, root := range rootClasses(classes) {
+		if i > 0 {
+			builder.out.WriteString("\n")
+		}
+		builder.out.WriteString("message " + root.Name + " {\n")
+		builder.group(root, 1)
+		builder.out.WriteString("}\n")
+	}
+
+	return builder.out.String(), nil
+}
+
+func (b *parquetBuilder) group(class models.ClassDefinition, depth int) {
+	b.visiting[class.Name] = true
+	defer delete(b.visiting, class.Name)
+
+	for _, field := range class.Fields {
+		repetition := "required"
+		if field.IsOptional {
+			repetition = "optional"
+		}
+		b.field(repetition, formatAvroFieldName(field), field, depth)
+	}
+}
+
+// field writes one column. Lists use the three-level LIST layout and maps
+// the MAP/key_value layout from the Parquet logical type specification.
+func (b *parquetBuilder) field(repetition, name string, field models.FieldDefinition, depth int) {
+	indent := strings.Repeat("  ", depth)
+
+	if field.IsList {
+		element := field
+		element.IsList = false
+		b.out.WriteString(indent + repetition + " group " + name + " (LIST) {\n")
+		b.out.WriteString(indent + "  repeated group list {\n")
+		b.field("required", "element", element, depth+2)
+		b.out.WriteString(indent + "  }\n")
+		b.out.WriteString(indent + "}\n")
+		return
+	}
+
+	if field.IsMap {
+		value := field
+		value.IsMap = false
+		b.out.WriteString(indent + repetition + " group " + name + " (MAP) {\n")
+		b.out.WriteString(indent + "  repeated group key_value {\n")
+		b.out.WriteString(indent + "    required binary key (STRING);\n")
+		b.field("optional", "value", value, depth+2)
+		b.out.WriteString(indent + "  }\n")
+		b.out.WriteString(indent + "}\n")
+		return
+	}
+
+	// A group needs at least one child, so an empty object is stored as
+	// JSON text like a dynamic value.
+	if class, ok := b.classes[field.TypeName]; ok && !b.visiting[class.Name] && len(class.Fields) > 0 {
+		b.out.WriteString(indent + repetition + " group " + name + " {\n")
+		b.group(class, depth+1)
+		b.out.WriteString(indent + "}\n")
+		return
+	}
+
+	b.out.WriteString(indent + repetition + " " + fmt.Sprintf(convertParquetType(field), name) + ";\n")
+}
+
+// convertParquetType returns the primitive column type with a %s verb where
+// the column name goes, since annotations follow the name.
+func convertParquetType(field models.FieldDefinition) string {
+	switch field.TypeName {
+	case "string":
+		switch field.Format {
+		case "date-time":
+			return "int64 %s (TIMESTAMP(MILLIS,true))"
+		case "date":
+			return "int32 %s (DATE)"
+		}
+		return "binary %s (STRING)"
+	case "int", "int64":
+		return "int64 %s"
+	case "float64":
+		return "double %s"
+	case "bool":
+		return "boolean %s"
+	default:
+		// Dynamic values, empty objects and recursive references are stored
+		// as JSON text.
+		return "binary %s (JSON)"
+	}
+}
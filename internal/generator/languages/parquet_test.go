@@ -0,0 +1,97 @@
+package languages
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/jguerreno/JSON-Converter/internal/models"
+)
+
+func TestConvertParquetType(t *testing.T) {
+	tests := []struct {
+		field models.FieldDefinition
+		want  string
+	}{
+		{models.FieldDefinition{TypeName: "string"}, "binary %s (STRING)"},
+		{models.FieldDefinition{TypeName: "int"}, "int64 %s"},
+		{models.FieldDefinition{TypeName: "float64"}, "double %s"},
+		{models.FieldDefinition{TypeName: "bool"}, "boolean %s"},
+		{models.FieldDefinition{TypeName: "interface{}"}, "binary %s (JSON)"},
+		{models.FieldDefinition{TypeName: "string", Format: "date-time"}, "int64 %s (TIMESTAMP(MILLIS,true))"},
+		{models.FieldDefinition{TypeName: "string", Format: "date"}, "int32 %s (DATE)"},
+	}
+
+	for _, tt := range tests {
+		result := convertParquetType(tt.field)
+		if result != tt.want {
+			t.Errorf("convertParquetType(%v) = %q, want %q", tt.field, result, tt.want)
+		}
+	}
+}
+
+func TestGenerateParquet(t *testing.T) {
+	code, err := NewParquetGenerator().Generate(sqlTestClasses)
+	if err != nil {
+		t.Fatalf("GenerateParquet failed: %v", err)
+	}
+
+	expectedCode := []string{
+		"message Order {",
+		"  required binary id (STRING);",
+		"  required int64 created_at (TIMESTAMP(MILLIS,true));",
+		"  optional binary note (STRING);",
+		"  optional group address {\n    required binary city (STRING);\n  }",
+		"  required group lines (LIST) {\n    repeated group list {\n      required group element {",
+		"        required int64 qty;",
+		"  required binary meta (JSON);",
+	}
+
+	for _, expected := range expectedCode {
+		if !strings.Contains(code, expected) {
+			t.Errorf("Generated Parquet schema missing: %q", expected)
+		}
+	}
+	if strings.Contains(code, "message Address") {
+		t.Error("nested classes should be groups, not separate messages")
+	}
+}
+
+func TestGenerateParquetMap(t *testing.T) {
+	classes := []models.ClassDefinition{{
+		Name: "Stats",
+		Fields: []models.FieldDefinition{
+			{Name: "Counts", JSONTag: "counts", TypeName: "int", IsMap: true, IsOptional: true},
+		},
+	}}
+
+	code, err := NewParquetGenerator().Generate(classes)
+	if err != nil {
+		t.Fatalf("GenerateParquet failed: %v", err)
+	}
+
+	expected := "  optional group counts (MAP) {\n    repeated group key_value {\n      required binary key (STRING);\n      optional int64 value;\n    }\n  }"
+	if !strings.Contains(code, expected) {
+		t.Errorf("Generated Parquet schema missing map layout, got:\n%s", code)
+	}
+}
+
+func TestGenerateParquetEmptyObject(t *testing.T) {
+	classes := []models.ClassDefinition{
+		{
+			Name: "Event",
+			Fields: []models.FieldDefinition{
+				{Name: "Meta", JSONTag: "meta", TypeName: "Meta"},
+			},
+		},
+		{Name: "Meta"},
+	}
+
+	code, err := NewParquetGenerator().Generate(classes)
+	if err != nil {
+		t.Fatalf("GenerateParquet failed: %v", err)
+	}
+
+	if !strings.Contains(code, "  required binary meta (JSON);\n") || strings.Contains(code, "group meta") {
+		t.Errorf("Expected the empty object to be stored as JSON, got:\n%s", code)
+	}
+}
@@ -0,0 +1,335 @@
+package languages
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/jguerreno/JSON-Converter/internal/conventions"
+	"github.com/jguerreno/JSON-Converter/internal/models"
+)
+
+// ProtoGenerator emits proto3 messages with fields numbered in order. The
+// "lock" option takes the text of a previously generated file: its fields
+// keep their numbers and those of removed fields are reserved, so that the
+// wire format stays compatible across regenerations.
+type ProtoGenerator struct {
+	template *template.Template
+}
+
+func NewProtoGenerator() *ProtoGenerator {
+	return &ProtoGenerator{
+		template: template.Must(template.New("proto").Funcs(getProtoTemplateFuncs()).Parse(protoTemplate)),
+	}
+}
+
+func (p *ProtoGenerator) GetName() string {
+	return "proto"
+}
+
+func (p *ProtoGenerator) GetFileExtension() string {
+	return "proto"
+}
+
+func (p *ProtoGenerator) TemplateFuncs() template.FuncMap {
+	return getProtoTemplateFuncs()
+}
+
+func (p *ProtoGenerator) Generate(classes []models.ClassDefinition) (string, error) {
+	return p.GenerateWithOptions(classes, nil)
+}
+
+func (p *ProtoGenerator) GenerateWithOptions(classes []models.ClassDefinition, opts models.Options) (string, error) {
+	locks := parseProtoLock(opts.Get("lock", ""))
+	messages := make(map[string]protoNumbering, len(classes))
+	for _, class := range classes {
+		messages[class.Name] = numberProtoFields(class, locks[class.Name])
+	}
+
+	var buf strings.Builder
+	if err := p.template.Execute(&buf, map[string]interface{}{
+		"Classes":   classes,
+		"Messages":  messages,
+		"Options":   opts,
+		"Package":   opts.Get("package", "models"),
+		"GoPackage": opts.Get("go_package", ""),
+		"Imports":   protoImports(classes),
+	}); err != nil {
+		return "", fmt.Errorf("failed to execute template for %s: %w", p.GetName(), err)
+	}
+
+	return buf.String(), nil
+}
+
+func convertProtoType(field models.FieldDefinition) string {
+	switch field.TypeName {
+	case "string":
+		if field.Format == "date-time" {
+			return "google.protobuf.Timestamp"
+		}
+		return "string"
+	case "int", "int64":
+		return "int64"
+	case "float64":
+		return "double"
+	case "bool":
+		return "bool"
+	case "interface{}":
+		return "google.protobuf.Value"
+	default:
+		return field.TypeName
+	}
+}
+
+func getProtoTemplateFuncs() template.FuncMap {
+	return template.FuncMap{
+		"convertType": func(typeName string) string {
+			return convertProtoType(models.FieldDefinition{TypeName: typeName})
+		},
+		"formatType":   formatProtoType,
+		"fieldName":    formatProtoFieldName,
+		"fieldOptions": formatProtoFieldOptions,
+		"fieldNumber":  func(index int) int { return index + 1 },
+	}
+}
+
+func formatProtoType(field models.FieldDefinition) string {
+	valueType := convertProtoType(field)
+
+	if field.IsMap {
+		mapType := "map<string, " + valueType + ">"
+		if field.TypeName == "interface{}" {
+			mapType = "google.protobuf.Struct"
+		}
+		if field.IsList {
+			// Maps cannot be repeated, so a list of maps becomes a list of Structs.
+			return "repeated google.protobuf.Struct"
+		}
+		return mapType
+	}
+
+	if field.IsList {
+		return "repeated " + valueType
+	}
+	if field.IsOptional && isProtoScalar(valueType) {
+		return "optional " + valueType
+	}
+	return valueType
+}
+
+func formatProtoFieldName(field models.FieldDefinition) string {
+	return conventions.ToSnakeCase(field.Name)
+}
+
+// formatProtoFieldOptions keeps the original JSON key when it differs from
+// the name protojson would derive from the snake_case field name.
+func formatProtoFieldOptions(field models.FieldDefinition) string {
+	if field.JSONTag == "" || field.JSONTag == conventions.ToCamelCase(formatProtoFieldName(field)) {
+		return ""
+	}
+	return fmt.Sprintf(" [json_name = %q]", field.JSONTag)
+}
+
+// protoReservedFirst and protoReservedLast bound the field numbers protobuf
+// keeps for its own use.
+const (
+	protoReservedFirst = 19000
+	protoReservedLast  = 19999
+)
+
+var (
+	protoMessageLine  = regexp.MustCompile(`^\s*message\s+(\w+)\s*\{`)
+	protoFieldLine    = regexp.MustCompile(`^\s*(?:(?:optional|repeated)\s+)?[\w.]+(?:<[^>]*>)?\s+(\w+)\s*=\s*(\d+)`)
+	protoReservedLine = regexp.MustCompile(`^\s*reserved\s+([^;]*);`)
+)
+
+// protoLock is the numbering of one message in a previously generated file.
+type protoLock struct {
+	numbers       map[string]int
+	reserved      []int
+	reservedNames []string
+}
+
+// parseProtoLock reads the field numbers and reserved numbers and names of
+// every message in text. Lines it does not recognize are ignored.
+func parseProtoLock(text string) map[string]*protoLock {
+	locks := map[string]*protoLock{}
+	var current *protoLock
+	for _, line := range strings.Split(text, "\n") {
+		if match := protoMessageLine.FindStringSubmatch(line); match != nil {
+			current = &protoLock{numbers: map[string]int{}}
+			locks[match[1]] = current
+			continue
+		}
+		if current == nil {
+			continue
+		}
+		if match := protoReservedLine.FindStringSubmatch(line); match != nil {
+			for _, item := range strings.Split(match[1], ",") {
+				item = strings.TrimSpace(item)
+				if name, err := strconv.Unquote(item); err == nil {
+					current.reservedNames = append(current.reservedNames, name)
+					continue
+				}
+				first, last, _ := strings.Cut(item, " to ")
+				from, err := strconv.Atoi(strings.TrimSpace(first))
+				if err != nil {
+					continue
+				}
+				to := from
+				if last != "" {
+					if to, err = strconv.Atoi(strings.TrimSpace(last)); err != nil {
+						continue
+					}
+				}
+				for number := from; number <= to; number++ {
+					current.reserved = append(current.reserved, number)
+				}
+			}
+			continue
+		}
+		if match := protoFieldLine.FindStringSubmatch(line); match != nil {
+			current.numbers[match[1]], _ = strconv.Atoi(match[2])
+		}
+	}
+	return locks
+}
+
+// protoNumbering is the numbering of one generated message: the number of
+// each field, in order, and the reserved statements listing the numbers and
+// names retired since the locked version.
+type protoNumbering struct {
+	Numbers       []int
+	Reserved      string
+	ReservedNames string
+}
+
+// numberProtoFields numbers the fields of class sequentially. Fields found
+// in lock keep their number; the numbers and names of locked fields that
+// are gone are reserved so that they are never reused, and new fields take
+// the lowest numbers never used.
+func numberProtoFields(class models.ClassDefinition, lock *protoLock) protoNumbering {
+	if lock == nil {
+		lock = &protoLock{}
+	}
+	numbers := make([]int, len(class.Fields))
+	used := map[int]bool{}
+	present := map[string]bool{}
+	for i, field := range class.Fields {
+		name := formatProtoFieldName(field)
+		present[name] = true
+		if number, ok := lock.numbers[name]; ok {
+			numbers[i] = number
+			used[number] = true
+		}
+	}
+
+	reserved := append([]int{}, lock.reserved...)
+	var reservedNames []string
+	for _, name := range lock.reservedNames {
+		if !present[name] {
+			reservedNames = append(reservedNames, name)
+		}
+	}
+	for name, number := range lock.numbers {
+		if !present[name] {
+			reserved = append(reserved, number)
+			reservedNames = append(reservedNames, name)
+		}
+	}
+	for _, number := range reserved {
+		used[number] = true
+	}
+
+	next := 1
+	for i := range numbers {
+		if numbers[i] != 0 {
+			continue
+		}
+		for used[next] || (next >= protoReservedFirst && next <= protoReservedLast) {
+			next++
+		}
+		numbers[i] = next
+		used[next] = true
+	}
+
+	sort.Ints(reserved)
+	sort.Strings(reservedNames)
+	numbering := protoNumbering{Numbers: numbers}
+	var items []string
+	for i, number := range reserved {
+		if i == 0 || number != reserved[i-1] {
+			items = append(items, strconv.Itoa(number))
+		}
+	}
+	numbering.Reserved = strings.Join(items, ", ")
+	items = items[:0]
+	for i, name := range reservedNames {
+		if i == 0 || name != reservedNames[i-1] {
+			items = append(items, strconv.Quote(name))
+		}
+	}
+	numbering.ReservedNames = strings.Join(items, ", ")
+	return numbering
+}
+
+func isProtoScalar(protoType string) bool {
+	switch protoType {
+	case "string", "int64", "double", "bool":
+		return true
+	default:
+		return false
+	}
+}
+
+func protoImports(classes []models.ClassDefinition) []string {
+	imports := make(map[string]bool)
+	for _, class := range classes {
+		for _, field := range class.Fields {
+			switch {
+			case field.TypeName == "interface{}":
+				imports["google/protobuf/struct.proto"] = true
+			case field.IsMap && field.IsList:
+				imports["google/protobuf/struct.proto"] = true
+			case field.TypeName == "string" && field.Format == "date-time":
+				imports["google/protobuf/timestamp.proto"] = true
+			}
+		}
+	}
+
+	result := make([]string, 0, len(imports))
+	for imp := range imports {
+		result = append(result, imp)
+	}
+	sort.Strings(result)
+	return result
+}
+
+var protoTemplate = `syntax = "proto3";
+
+package {{ .Package }};
+{{ if .Imports }}
+{{- range .Imports }}
+import "{{ . }}";
+{{- end }}
+{{ end }}
+{{- if .GoPackage }}
+option go_package = "{{ .GoPackage }}";
+{{ end }}
+{{- range .Classes }}
+{{- $message := index $.Messages .Name }}
+message {{ .Name }} {
+{{- range $index, $field := .Fields }}
+  {{ formatType $field }} {{ fieldName $field }} = {{ index $message.Numbers $index }}{{ fieldOptions $field }};
+{{- end }}
+{{- with $message.Reserved }}
+  reserved {{ . }};
+{{- end }}
+{{- with $message.ReservedNames }}
+  reserved {{ . }};
+{{- end }}
+}
+{{ end }}`
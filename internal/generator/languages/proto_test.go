@@ -0,0 +1,190 @@
+package languages
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/jguerreno/JSON-Converter/internal/models"
+)
+
+func TestConvertProtoType(t *testing.T) {
+	tests := []struct {
+		field models.FieldDefinition
+		want  string
+	}{
+		{models.FieldDefinition{TypeName: "string"}, "string"},
+		{models.FieldDefinition{TypeName: "string", Format: "date-time"}, "google.protobuf.Timestamp"},
+		{models.FieldDefinition{TypeName: "int"}, "int64"},
+		{models.FieldDefinition{TypeName: "int64"}, "int64"},
+		{models.FieldDefinition{TypeName: "float64"}, "double"},
+		{models.FieldDefinition{TypeName: "bool"}, "bool"},
+		{models.FieldDefinition{TypeName: "MiClase"}, "MiClase"},
+		{models.FieldDefinition{TypeName: "interface{}"}, "google.protobuf.Value"},
+	}
+
+	for _, tt := range tests {
+		result := convertProtoType(tt.field)
+		if result != tt.want {
+			t.Errorf("convertProtoType(%v) = %q, want %q", tt.field, result, tt.want)
+		}
+	}
+}
+
+func TestProtoFormatType(t *testing.T) {
+	tests := []struct {
+		field models.FieldDefinition
+		want  string
+	}{
+		{
+			field: models.FieldDefinition{TypeName: "string"},
+			want:  "string",
+		},
+		{
+			field: models.FieldDefinition{TypeName: "string", IsOptional: true},
+			want:  "optional string",
+		},
+		{
+			field: models.FieldDefinition{TypeName: "string", IsOptional: true, IsList: true},
+			want:  "repeated string",
+		},
+		{
+			field: models.FieldDefinition{TypeName: "City", IsOptional: true},
+			want:  "City",
+		},
+		{
+			field: models.FieldDefinition{TypeName: "int", IsMap: true},
+			want:  "map<string, int64>",
+		},
+		{
+			field: models.FieldDefinition{TypeName: "interface{}", IsMap: true},
+			want:  "google.protobuf.Struct",
+		},
+	}
+
+	for _, tt := range tests {
+		result := formatProtoType(tt.field)
+		if result != tt.want {
+			t.Errorf("formatType(%v) = %q, want %q", tt.field, result, tt.want)
+		}
+	}
+}
+
+func TestGenerateProto(t *testing.T) {
+	classes := []models.ClassDefinition{
+		{
+			Name: "City",
+			Fields: []models.FieldDefinition{
+				{Name: "Name", JSONTag: "name", TypeName: "string"},
+				{Name: "Population", JSONTag: "population", TypeName: "int"},
+			},
+		},
+		{
+			Name: "User",
+			Fields: []models.FieldDefinition{
+				{Name: "Name", JSONTag: "name", TypeName: "string"},
+				{Name: "Email", JSONTag: "email", TypeName: "string", IsOptional: true},
+				{Name: "Tags", JSONTag: "tags", TypeName: "string", IsList: true},
+				{Name: "City", JSONTag: "city", TypeName: "City"},
+				{Name: "CreatedAt", JSONTag: "created_at", TypeName: "string", Format: "date-time"},
+				{Name: "Extra", JSONTag: "extra", TypeName: "interface{}"},
+			},
+		},
+	}
+
+	code, err := NewProtoGenerator().GenerateWithOptions(classes, models.Options{
+		"package":    "users.v1",
+		"go_package": "example.com/users/v1",
+	})
+	if err != nil {
+		t.Fatalf("GenerateProto failed: %v", err)
+	}
+
+	expectedCode := []string{
+		`syntax = "proto3";`,
+		`package users.v1;`,
+		`import "google/protobuf/struct.proto";`,
+		`import "google/protobuf/timestamp.proto";`,
+		`option go_package = "example.com/users/v1";`,
+		`message City {`,
+		`string name = 1;`,
+		`int64 population = 2;`,
+		`message User {`,
+		`optional string email = 2;`,
+		`repeated string tags = 3;`,
+		`City city = 4;`,
+		`google.protobuf.Timestamp created_at = 5 [json_name = "created_at"];`,
+		`google.protobuf.Value extra = 6;`,
+	}
+
+	for _, expected := range expectedCode {
+		if !strings.Contains(code, expected) {
+			t.Errorf("Generated proto code missing: %q", expected)
+		}
+	}
+}
+
+func TestGenerateProtoDefaults(t *testing.T) {
+	code, err := NewProtoGenerator().Generate([]models.ClassDefinition{{Name: "Empty"}})
+	if err != nil {
+		t.Fatalf("GenerateProto failed: %v", err)
+	}
+
+	if !strings.Contains(code, "package models;") {
+		t.Error("expected default package models")
+	}
+	if strings.Contains(code, "import ") || strings.Contains(code, "go_package") {
+		t.Errorf("expected no imports or go_package option, got:\n%s", code)
+	}
+}
+
+func TestGenerateProtoLock(t *testing.T) {
+	lock := `syntax = "proto3";
+
+message User {
+  string email = 1;
+  string name = 2;
+  map<string, int64> scores = 4;
+  reserved 3;
+  reserved "nickname";
+}
+`
+	classes := []models.ClassDefinition{{
+		Name: "User",
+		Fields: []models.FieldDefinition{
+			{Name: "Age", TypeName: "int"},
+			{Name: "Bio", TypeName: "string"},
+			{Name: "Name", TypeName: "string"},
+			{Name: "Scores", TypeName: "int", IsMap: true},
+		},
+	}}
+
+	code, err := NewProtoGenerator().GenerateWithOptions(classes, models.Options{"lock": lock})
+	if err != nil {
+		t.Fatalf("GenerateProto failed: %v", err)
+	}
+
+	// Kept fields keep their numbers, new ones take the lowest never used,
+	// and the numbers and names of removed fields stay reserved.
+	expectedCode := []string{
+		"  int64 age = 5;\n",
+		"  string bio = 6;\n",
+		"  string name = 2;\n",
+		"  map<string, int64> scores = 4;\n",
+		"  reserved 1, 3;\n",
+		`  reserved "email", "nickname";`,
+	}
+	for _, expected := range expectedCode {
+		if !strings.Contains(code, expected) {
+			t.Errorf("Generated proto code missing: %q\n%s", expected, code)
+		}
+	}
+
+	// Without a lock, fields are numbered in order.
+	code, err = NewProtoGenerator().Generate(classes)
+	if err != nil {
+		t.Fatalf("GenerateProto failed: %v", err)
+	}
+	if !strings.Contains(code, "  map<string, int64> scores = 4;\n}") || strings.Contains(code, "reserved") {
+		t.Errorf("expected sequential numbers without reservations, got:\n%s", code)
+	}
+}
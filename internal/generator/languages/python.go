@@ -2,19 +2,47 @@ package languages
 
 import (
 	"fmt"
+	"regexp"
+	"strconv"
 	"strings"
 	"text/template"
 
+	"github.com/jguerreno/JSON-Converter/internal/conventions"
 	"github.com/jguerreno/JSON-Converter/internal/models"
 )
 
+// PythonGenerator emits one of several class styles selected with the
+// "style" option: dataclass (default), pydantic, typeddict, attrs or
+// msgspec. Attributes are snake_case; the original JSON key is kept through
+// each library's own aliasing mechanism when it differs. With the "docs"
+// option classes and attributes get docstrings with their JSON path and
+// example values, or Field descriptions for pydantic.
 type PythonGenerator struct {
 	template *template.Template
 }
 
+var pythonStyles = map[string]bool{
+	"dataclass": true,
+	"pydantic":  true,
+	"typeddict": true,
+	"attrs":     true,
+	"msgspec":   true,
+}
+
+var pythonKeywords = map[string]bool{
+	"False": true, "None": true, "True": true, "and": true, "as": true, "assert": true,
+	"async": true, "await": true, "break": true, "class": true, "continue": true,
+	"def": true, "del": true, "elif": true, "else": true, "except": true, "finally": true,
+	"for": true, "from": true, "global": true, "if": true, "import": true, "in": true,
+	"is": true, "lambda": true, "nonlocal": true, "not": true, "or": true, "pass": true,
+	"raise": true, "return": true, "try": true, "while": true, "with": true, "yield": true,
+}
+
+var pythonIdentifier = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
 func NewPythonGenerator() *PythonGenerator {
 	return &PythonGenerator{
-		template: template.Must(template.New("python").Funcs(getPythonTemplateFuncs()).Parse(pythonTemplate)),
+		template: template.Must(template.Must(template.New("python").Funcs(getPythonTemplateFuncs()).Parse(pythonTemplate)).Parse(pythonCodecTemplate)),
 	}
 }
 
@@ -26,10 +54,33 @@ func (p *PythonGenerator) GetFileExtension() string {
 	return "py"
 }
 
+func (p *PythonGenerator) TemplateFuncs() template.FuncMap {
+	return getPythonTemplateFuncs()
+}
+
 func (p *PythonGenerator) Generate(classes []models.ClassDefinition) (string, error) {
+	return p.GenerateWithOptions(classes, nil)
+}
+
+func (p *PythonGenerator) GenerateWithOptions(classes []models.ClassDefinition, opts models.Options) (string, error) {
+	style := opts.Get("style", "dataclass")
+	if !pythonStyles[style] {
+		return "", fmt.Errorf("python style '%s' not supported", style)
+	}
+
+	codec := opts.Bool("codec") && pythonCodecStyles[style]
+	validate := opts.Bool("validate") && style == "pydantic"
+	docs := opts.Bool("docs")
+
 	var buf strings.Builder
 	if err := p.template.Execute(&buf, map[string]interface{}{
-		"Classes": classes,
+		"Classes":  sortByDependency(classes),
+		"Options":  opts,
+		"Style":    style,
+		"Codec":    codec,
+		"Validate": validate,
+		"Docs":     docs,
+		"Imports":  pythonImports(style, classes, codec, validate, docs),
 	}); err != nil {
 		return "", fmt.Errorf("failed to execute template for %s: %w", p.GetName(), err)
 	}
@@ -56,7 +107,20 @@ func convertPythonType(goType string) string {
 
 func getPythonTemplateFuncs() template.FuncMap {
 	return template.FuncMap{
-		"formatType": formatPythonType,
+		"convertType":    convertPythonType,
+		"formatType":     formatPythonType,
+		"fieldName":      formatPythonFieldName,
+		"fieldDefault":   formatPythonDefault,
+		"sortedFields":   sortPythonFields,
+		"hasAliases":     hasPythonAliases,
+		"msgspecRename":  formatMsgspecRename,
+		"identifierKeys": hasIdentifierKeys,
+		"fromDict":       formatPythonFromDict,
+		"toDict":         formatPythonToDict,
+		"requiredKeys":   requiredJSONKeys,
+		"fieldDoc":       formatFieldDoc,
+		"classDoc":       formatClassDoc,
+		"docstring":      formatPythonDocstring,
 	}
 }
 
@@ -68,7 +132,13 @@ func formatPythonType(field models.FieldDefinition) string {
 	if field.IsList {
 		typeBuilder.WriteString("list[")
 	}
+	if field.IsMap {
+		typeBuilder.WriteString("dict[str, ")
+	}
 	typeBuilder.WriteString(convertPythonType(field.TypeName))
+	if field.IsMap {
+		typeBuilder.WriteString("]")
+	}
 	if field.IsList {
 		typeBuilder.WriteString("]")
 	}
@@ -78,14 +148,286 @@ func formatPythonType(field models.FieldDefinition) string {
 	return typeBuilder.String()
 }
 
-var pythonTemplate = `
-from dataclasses import dataclass
-from typing import Optional
+// formatPythonFieldName turns a field into a snake_case attribute name,
+// adding a trailing underscore to names that are Python keywords.
+func formatPythonFieldName(field models.FieldDefinition) string {
+	name := conventions.ToSnakeCase(field.Name)
+	if name == "" {
+		name = "field"
+	}
+	if pythonKeywords[name] || !pythonIdentifier.MatchString(name) {
+		name += "_"
+	}
+	return name
+}
+
+// pythonAlias returns the JSON key when it differs from the attribute name.
+func pythonAlias(field models.FieldDefinition) string {
+	if field.JSONTag == "" || field.JSONTag == formatPythonFieldName(field) {
+		return ""
+	}
+	return field.JSONTag
+}
 
-{{ range .Classes }}
-@dataclass
-class {{.Name}}:
+func formatPythonDefault(style string, validate, docs bool, field models.FieldDefinition) string {
+	alias := pythonAlias(field)
+	switch style {
+	case "typeddict":
+		return ""
+	case "pydantic":
+		args := []string{}
+		if alias != "" {
+			args = append(args, fmt.Sprintf("alias=%q", alias))
+		}
+		if validate {
+			args = append(args, pydanticConstraints(field)...)
+		}
+		if doc := formatFieldDoc(field); docs && doc != "" {
+			args = append(args, "description="+strconv.Quote(doc))
+		}
+		if len(args) > 0 {
+			if field.IsOptional {
+				args = append([]string{"default=None"}, args...)
+			}
+			return " = Field(" + strings.Join(args, ", ") + ")"
+		}
+	case "dataclass", "attrs":
+		if alias != "" && field.IsOptional {
+			return fmt.Sprintf(` = field(default=None, metadata={"json": %q})`, alias)
+		}
+		if alias != "" {
+			return fmt.Sprintf(` = field(metadata={"json": %q})`, alias)
+		}
+	}
+
+	if field.IsOptional {
+		return " = None"
+	}
+	return ""
+}
+
+// pydanticConstraints returns the Field arguments for the bounds observed
+// on a field: lengths for strings and lists, ranges for numbers.
+func pydanticConstraints(field models.FieldDefinition) []string {
+	constraints := field.Constraints
+	if constraints == nil || field.IsMap {
+		return nil
+	}
+
+	args := []string{}
+	minLength, maxLength := constraints.MinLength, constraints.MaxLength
+	if field.IsList {
+		minLength, maxLength = constraints.MinItems, constraints.MaxItems
+	}
+	if field.IsList || field.TypeName == "string" {
+		if minLength != nil && *minLength > 0 {
+			args = append(args, fmt.Sprintf("min_length=%d", *minLength))
+		}
+		if maxLength != nil {
+			args = append(args, fmt.Sprintf("max_length=%d", *maxLength))
+		}
+		return args
+	}
+
+	if constraints.Minimum != nil {
+		args = append(args, "ge="+formatPythonNumber(field.TypeName, *constraints.Minimum))
+	}
+	if constraints.Maximum != nil {
+		args = append(args, "le="+formatPythonNumber(field.TypeName, *constraints.Maximum))
+	}
+	return args
+}
+
+// formatPythonDocstring quotes a doc string as a docstring, escaping what
+// would end it early.
+func formatPythonDocstring(doc string) string {
+	doc = strings.ReplaceAll(doc, `\`, `\\`)
+	doc = strings.ReplaceAll(doc, `"""`, `\"\"\"`)
+	if strings.HasSuffix(doc, `"`) {
+		doc = doc[:len(doc)-1] + `\"`
+	}
+	return `"""` + doc + `"""`
+}
+
+func formatPythonNumber(typeName string, value float64) string {
+	if typeName == "float64" && value == float64(int64(value)) {
+		return strconv.FormatFloat(value, 'f', 1, 64)
+	}
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
+
+// sortPythonFields moves optional fields after required ones, since fields
+// with a default may not precede fields without one.
+func sortPythonFields(fields []models.FieldDefinition) []models.FieldDefinition {
+	sorted := make([]models.FieldDefinition, 0, len(fields))
+	for _, optional := range []bool{false, true} {
+		for _, field := range fields {
+			if field.IsOptional == optional {
+				sorted = append(sorted, field)
+			}
+		}
+	}
+	return sorted
+}
+
+func hasPythonAliases(class models.ClassDefinition) bool {
+	for _, field := range class.Fields {
+		if pythonAlias(field) != "" {
+			return true
+		}
+	}
+	return false
+}
+
+func formatMsgspecRename(class models.ClassDefinition) string {
+	renames := []string{}
+	for _, field := range class.Fields {
+		if alias := pythonAlias(field); alias != "" {
+			renames = append(renames, fmt.Sprintf("%q: %q", formatPythonFieldName(field), alias))
+		}
+	}
+	if len(renames) == 0 {
+		return ""
+	}
+	return ", rename={" + strings.Join(renames, ", ") + "}"
+}
+
+// hasIdentifierKeys reports whether a TypedDict can use the class syntax,
+// which needs every JSON key to be a plain identifier.
+func hasIdentifierKeys(class models.ClassDefinition) bool {
+	for _, field := range class.Fields {
+		if !pythonIdentifier.MatchString(field.JSONTag) || pythonKeywords[field.JSONTag] {
+			return false
+		}
+	}
+	return true
+}
+
+func pythonImports(style string, classes []models.ClassDefinition, codec, validate, docs bool) []string {
+	hasAlias := false
+	for _, class := range classes {
+		hasAlias = hasAlias || hasPythonAliases(class)
+	}
+	hasConstraints := validate && usesType(classes, func(field models.FieldDefinition) bool {
+		return len(pydanticConstraints(field)) > 0
+	})
+	hasDescriptions := docs && usesType(classes, func(field models.FieldDefinition) bool {
+		return formatFieldDoc(field) != ""
+	})
+
+	typing := []string{}
+	if codec || usesType(classes, func(field models.FieldDefinition) bool { return field.TypeName == "interface{}" }) {
+		typing = append(typing, "Any")
+	}
+	if style == "typeddict" && usesType(classes, func(field models.FieldDefinition) bool { return field.IsOptional }) {
+		typing = append(typing, "NotRequired")
+	}
+	if usesType(classes, func(field models.FieldDefinition) bool { return field.IsOptional }) {
+		typing = append(typing, "Optional")
+	}
+	if style == "typeddict" {
+		typing = append(typing, "TypedDict")
+	}
+
+	imports := []string{}
+	if style == "dataclass" {
+		if hasAlias {
+			imports = append(imports, "from dataclasses import dataclass, field")
+		} else {
+			imports = append(imports, "from dataclasses import dataclass")
+		}
+	}
+	if len(typing) > 0 {
+		imports = append(imports, "from typing import "+strings.Join(typing, ", "))
+	}
+
+	var library string
+	switch style {
+	case "pydantic":
+		library = "from pydantic import BaseModel"
+		if hasAlias {
+			library += ", ConfigDict"
+		}
+		if hasAlias || hasConstraints || hasDescriptions {
+			library += ", Field"
+		}
+	case "attrs":
+		library = "from attrs import define"
+		if hasAlias {
+			library += ", field"
+		}
+	case "msgspec":
+		library = "from msgspec import Struct"
+	}
+	if library != "" {
+		if len(imports) > 0 {
+			imports = append(imports, "")
+		}
+		imports = append(imports, library)
+	}
+	return imports
+}
+
+var pythonTemplate = `from __future__ import annotations
+
+{{ range .Imports }}{{ . }}
+{{ end }}
+{{- if .Codec }}{{ template "codec helpers" . }}
+{{ end }}
+{{- range .Classes }}
+{{- if eq $.Style "typeddict" }}
+{{- if identifierKeys . }}
+
+class {{ .Name }}(TypedDict):
+{{- if $.Docs }}{{ with classDoc . }}
+    {{ docstring . }}
+{{- end }}{{ end }}
 {{- range .Fields }}
-    {{.JSONTag}}: {{formatType .}}{{if .IsOptional}} = None{{end}}
+    {{ .JSONTag }}: {{ if .IsOptional }}NotRequired[{{ formatType . }}]{{ else }}{{ formatType . }}{{ end }}
+{{- if $.Docs }}{{ with fieldDoc . }}
+    {{ docstring . }}
+{{- end }}{{ end }}
+{{- end }}
+{{- if not .Fields }}
+    pass
+{{- end }}
+{{- else }}
+{{ if $.Docs }}{{ with classDoc . }}
+# {{ . }}
+{{- end }}{{ end }}
+{{ .Name }} = TypedDict("{{ .Name }}", {
+{{- range .Fields }}
+{{- if $.Docs }}{{ with fieldDoc . }}
+    # {{ . }}
+{{- end }}{{ end }}
+    "{{ .JSONTag }}": {{ if .IsOptional }}NotRequired[{{ formatType . }}]{{ else }}{{ formatType . }}{{ end }},
+{{- end }}
+})
+{{- end }}
+{{- else }}
+
+{{ if eq $.Style "dataclass" }}@dataclass
+class {{ .Name }}:
+{{- else if eq $.Style "attrs" }}@define
+class {{ .Name }}:
+{{- else if eq $.Style "pydantic" }}class {{ .Name }}(BaseModel):
+{{- else if eq $.Style "msgspec" }}class {{ .Name }}(Struct{{ msgspecRename . }}):
+{{- end }}
+{{- if $.Docs }}{{ with classDoc . }}
+    {{ docstring . }}
+{{- end }}{{ end }}
+{{- if and (eq $.Style "pydantic") (hasAliases .) }}
+    model_config = ConfigDict(populate_by_name=True)
+{{ end }}
+{{- range sortedFields .Fields }}
+    {{ fieldName . }}: {{ formatType . }}{{ fieldDefault $.Style $.Validate $.Docs . }}
+{{- if and $.Docs (ne $.Style "pydantic") }}{{ with fieldDoc . }}
+    {{ docstring . }}
+{{- end }}{{ end }}
+{{- end }}
+{{- if $.Codec }}{{ template "codec" . }}
+{{- else if not .Fields }}
+    pass
+{{- end }}
 {{- end }}
 {{ end }}`
@@ -0,0 +1,102 @@
+package languages
+
+import (
+	"fmt"
+
+	"github.com/jguerreno/JSON-Converter/internal/models"
+)
+
+// pythonCodecStyles are the styles that get from_dict/to_dict methods with
+// the "codec" option; pydantic and msgspec bring their own and TypedDicts
+// are plain dicts already.
+var pythonCodecStyles = map[string]bool{
+	"dataclass": true,
+	"attrs":     true,
+}
+
+// formatPythonFromDict returns the constructor argument for a field read
+// from the dict called data.
+func formatPythonFromDict(field models.FieldDefinition) string {
+	source := fmt.Sprintf("data[%q]", field.JSONTag)
+	converted := pythonConvert(field, source, func(value string) string {
+		return field.TypeName + ".from_dict(" + value + ")"
+	})
+	if !field.IsOptional {
+		return converted
+	}
+	if converted == source {
+		return fmt.Sprintf("data.get(%q)", field.JSONTag)
+	}
+	return fmt.Sprintf("%s if data.get(%q) is not None else None", converted, field.JSONTag)
+}
+
+func formatPythonToDict(field models.FieldDefinition) string {
+	return pythonConvert(field, "self."+formatPythonFieldName(field), func(value string) string {
+		return value + ".to_dict()"
+	})
+}
+
+// pythonConvert applies convert to every class value inside source, walking
+// through lists and dict values; other values are passed through as is.
+func pythonConvert(field models.FieldDefinition, source string, convert func(string) string) string {
+	if isPrimitiveType(field.TypeName) {
+		return source
+	}
+
+	value := convert
+	if field.IsMap {
+		value = func(mapping string) string {
+			return "{key: " + convert("value") + " for key, value in " + mapping + ".items()}"
+		}
+	}
+	if field.IsList {
+		return "[" + value("item") + " for item in " + source + "]"
+	}
+	return value(source)
+}
+
+func requiredJSONKeys(class models.ClassDefinition) []string {
+	keys := []string{}
+	for _, field := range class.Fields {
+		if !field.IsOptional {
+			keys = append(keys, field.JSONTag)
+		}
+	}
+	return keys
+}
+
+var pythonCodecTemplate = `
+{{- define "codec helpers" }}
+
+def _require_keys(data: Any, name: str, keys: list[str]) -> dict[str, Any]:
+    if not isinstance(data, dict):
+        raise TypeError(f"{name}: expected object, got {type(data).__name__}")
+    missing = [key for key in keys if key not in data]
+    if missing:
+        raise ValueError(f"{name}: missing required fields {missing}")
+    return data
+{{- end }}
+
+{{- define "codec" }}
+
+    @classmethod
+    def from_dict(cls, data: dict[str, Any]) -> {{ .Name }}:
+        _require_keys(data, "{{ .Name }}", [{{ range $index, $key := requiredKeys . }}{{ if $index }}, {{ end }}{{ printf "%q" $key }}{{ end }}])
+        return cls(
+{{- range .Fields }}
+            {{ fieldName . }}={{ fromDict . }},
+{{- end }}
+        )
+
+    def to_dict(self) -> dict[str, Any]:
+        result: dict[str, Any] = {
+{{- range .Fields }}{{ if not .IsOptional }}
+            {{ printf "%q" .JSONTag }}: {{ toDict . }},
+{{- end }}{{ end }}
+        }
+{{- range .Fields }}{{ if .IsOptional }}
+        if self.{{ fieldName . }} is not None:
+            result[{{ printf "%q" .JSONTag }}] = {{ toDict . }}
+{{- end }}{{ end }}
+        return result
+{{- end }}`
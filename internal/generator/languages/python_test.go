@@ -121,3 +121,235 @@ func TestGeneratePython(t *testing.T) {
 	}
 
 }
+
+var pythonStyleClasses = []models.ClassDefinition{
+	{
+		Name: "User",
+		Fields: []models.FieldDefinition{
+			{Name: "FirstName", JSONTag: "first-name", TypeName: "string"},
+			{Name: "Email", JSONTag: "email", TypeName: "string", IsOptional: true},
+			{Name: "Class", JSONTag: "class", TypeName: "string"},
+			{Name: "Extra", JSONTag: "extra", TypeName: "interface{}"},
+			{Name: "City", JSONTag: "city", TypeName: "City"},
+		},
+	},
+	{
+		Name: "City",
+		Fields: []models.FieldDefinition{
+			{Name: "Name", JSONTag: "name", TypeName: "string"},
+		},
+	},
+}
+
+func TestFormatPythonFieldName(t *testing.T) {
+	tests := []struct {
+		field models.FieldDefinition
+		want  string
+	}{
+		{models.FieldDefinition{Name: "FirstName", JSONTag: "first-name"}, "first_name"},
+		{models.FieldDefinition{Name: "CreatedAt", JSONTag: "createdAt"}, "created_at"},
+		{models.FieldDefinition{Name: "Class", JSONTag: "class"}, "class_"},
+	}
+
+	for _, tt := range tests {
+		result := formatPythonFieldName(tt.field)
+		if result != tt.want {
+			t.Errorf("formatPythonFieldName(%v) = %q, want %q", tt.field, result, tt.want)
+		}
+	}
+}
+
+func TestGeneratePythonStyles(t *testing.T) {
+	tests := []struct {
+		style    string
+		expected []string
+	}{
+		{
+			style: "dataclass",
+			expected: []string{
+				"from __future__ import annotations",
+				"from dataclasses import dataclass, field",
+				"from typing import Any, Optional",
+				`    first_name: str = field(metadata={"json": "first-name"})`,
+				`    class_: str = field(metadata={"json": "class"})`,
+				"    extra: Any\n    city: City\n    email: Optional[str] = None",
+			},
+		},
+		{
+			style: "pydantic",
+			expected: []string{
+				"from pydantic import BaseModel, ConfigDict, Field",
+				"class User(BaseModel):\n    model_config = ConfigDict(populate_by_name=True)",
+				`    first_name: str = Field(alias="first-name")`,
+				"    email: Optional[str] = None",
+				"class City(BaseModel):\n    name: str",
+			},
+		},
+		{
+			style: "typeddict",
+			expected: []string{
+				"from typing import Any, NotRequired, Optional, TypedDict",
+				`User = TypedDict("User", {`,
+				`    "first-name": str,`,
+				`    "email": NotRequired[Optional[str]],`,
+				"class City(TypedDict):\n    name: str",
+			},
+		},
+		{
+			style: "attrs",
+			expected: []string{
+				"from attrs import define, field",
+				"@define\nclass User:",
+				`    first_name: str = field(metadata={"json": "first-name"})`,
+			},
+		},
+		{
+			style: "msgspec",
+			expected: []string{
+				"from msgspec import Struct",
+				`class User(Struct, rename={"first_name": "first-name", "class_": "class"}):`,
+				"    first_name: str\n",
+				"class City(Struct):",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.style, func(t *testing.T) {
+			code, err := NewPythonGenerator().GenerateWithOptions(pythonStyleClasses, models.Options{"style": tt.style})
+			if err != nil {
+				t.Fatalf("GeneratePython failed: %v", err)
+			}
+			for _, expected := range tt.expected {
+				if !strings.Contains(code, expected) {
+					t.Errorf("Generated Python code missing: %q\n%s", expected, code)
+				}
+			}
+			if strings.Index(code, "City") > strings.Index(code, "User") {
+				t.Error("classes must be declared before the classes that use them")
+			}
+		})
+	}
+}
+
+func TestGeneratePythonUnknownStyle(t *testing.T) {
+	if _, err := NewPythonGenerator().GenerateWithOptions(pythonStyleClasses, models.Options{"style": "marshmallow"}); err == nil {
+		t.Error("expected error for unsupported style")
+	}
+}
+
+func TestGeneratePythonCodec(t *testing.T) {
+	classes := []models.ClassDefinition{
+		{
+			Name: "City",
+			Fields: []models.FieldDefinition{
+				{Name: "Name", JSONTag: "name", TypeName: "string"},
+			},
+		},
+		{
+			Name: "User",
+			Fields: []models.FieldDefinition{
+				{Name: "FirstName", JSONTag: "first-name", TypeName: "string"},
+				{Name: "Home", JSONTag: "home", TypeName: "City", IsOptional: true},
+				{Name: "Cities", JSONTag: "cities", TypeName: "City", IsList: true},
+				{Name: "ByName", JSONTag: "byName", TypeName: "City", IsMap: true},
+			},
+		},
+	}
+
+	code, err := NewPythonGenerator().GenerateWithOptions(classes, models.Options{"codec": "true"})
+	if err != nil {
+		t.Fatalf("GeneratePython failed: %v", err)
+	}
+
+	expectedCode := []string{
+		"from typing import Any, Optional",
+		"def _require_keys(data: Any, name: str, keys: list[str]) -> dict[str, Any]:",
+		"    def from_dict(cls, data: dict[str, Any]) -> User:",
+		`        _require_keys(data, "User", ["first-name", "cities", "byName"])`,
+		`            first_name=data["first-name"],`,
+		`            home=City.from_dict(data["home"]) if data.get("home") is not None else None,`,
+		`            cities=[City.from_dict(item) for item in data["cities"]],`,
+		`            by_name={key: City.from_dict(value) for key, value in data["byName"].items()},`,
+		`            "first-name": self.first_name,`,
+		`            "cities": [item.to_dict() for item in self.cities],`,
+		"        if self.home is not None:\n            result[\"home\"] = self.home.to_dict()",
+	}
+
+	for _, expected := range expectedCode {
+		if !strings.Contains(code, expected) {
+			t.Errorf("Generated Python code missing: %q\n%s", expected, code)
+		}
+	}
+
+	pydantic, err := NewPythonGenerator().GenerateWithOptions(classes, models.Options{"codec": "true", "style": "pydantic"})
+	if err != nil {
+		t.Fatalf("GeneratePython failed: %v", err)
+	}
+	if strings.Contains(pydantic, "from_dict") {
+		t.Error("pydantic models should rely on their own validation")
+	}
+}
+
+func TestGeneratePythonPydanticConstraints(t *testing.T) {
+	code, err := NewPythonGenerator().GenerateWithOptions(constrainedClasses, models.Options{"validate": "true", "style": "pydantic"})
+	if err != nil {
+		t.Fatalf("GeneratePython failed: %v", err)
+	}
+
+	expectedCode := []string{
+		"from pydantic import BaseModel, Field",
+		"    code: str = Field(min_length=3, max_length=8)",
+		"    qty: int = Field(ge=1, le=10)",
+		"    price: float = Field(ge=0.5)",
+		"    lines: list[Line] = Field(min_length=1)",
+		"    note: Optional[str] = Field(default=None, max_length=20)",
+		"    billing: Optional[Line] = None",
+	}
+
+	for _, expected := range expectedCode {
+		if !strings.Contains(code, expected) {
+			t.Errorf("Generated Python code missing: %q\n%s", expected, code)
+		}
+	}
+}
+
+func TestGeneratePythonDocs(t *testing.T) {
+	tests := []struct {
+		style        string
+		expectedCode []string
+	}{
+		{
+			style: "dataclass",
+			expectedCode: []string{
+				"class UsersItem:\n    \"\"\"Path: $.users[*]\"\"\"\n    email: str\n",
+				"    email: str\n    \"\"\"Example: \"alice@example.com\" (path: $.users[*].email)\"\"\"",
+			},
+		},
+		{
+			style: "pydantic",
+			expectedCode: []string{
+				"from pydantic import BaseModel, Field",
+				"class UsersItem(BaseModel):\n    \"\"\"Path: $.users[*]\"\"\"",
+				`    email: str = Field(description="Example: \"alice@example.com\" (path: $.users[*].email)")`,
+				`    age: Optional[int] = Field(default=None, description="Examples: 30, 41 (path: $.users[*].age)")`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		code, err := NewPythonGenerator().GenerateWithOptions(documentedClasses, models.Options{"style": tt.style, "docs": "true"})
+		if err != nil {
+			t.Fatalf("GeneratePython(%s) failed: %v", tt.style, err)
+		}
+		for _, expected := range tt.expectedCode {
+			if !strings.Contains(code, expected) {
+				t.Errorf("Generated Python %s code missing: %q\n%s", tt.style, expected, code)
+			}
+		}
+	}
+
+	if docstring := formatPythonDocstring(`Example: "a\b"`); docstring != `"""Example: "a\\b\""""` {
+		t.Errorf("formatPythonDocstring did not escape the docstring: %s", docstring)
+	}
+}
@@ -0,0 +1,335 @@
+package languages
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/jguerreno/JSON-Converter/internal/conventions"
+	"github.com/jguerreno/JSON-Converter/internal/models"
+)
+
+// SQLGenerator emits CREATE TABLE statements for the root classes. Nested
+// objects are either flattened into prefixed columns ("nested=flatten") or
+// stored in their own table referenced by a foreign key ("nested=tables");
+// lists of objects always become child tables pointing at their parent.
+type SQLGenerator struct {
+	template *template.Template
+}
+
+type sqlDialect struct {
+	quote  func(string) string
+	types  map[string]string
+	serial string
+}
+
+var sqlDialects = map[string]sqlDialect{
+	"postgres": {
+		quote: func(name string) string { return `"` + name + `"` },
+		types: map[string]string{
+			"int": "BIGINT", "int64": "BIGINT", "float64": "DOUBLE PRECISION", "bool": "BOOLEAN",
+			"string": "TEXT", "date-time": "TIMESTAMPTZ", "date": "DATE", "uuid": "UUID", "json": "JSONB",
+		},
+		serial: "BIGSERIAL",
+	},
+	"mysql": {
+		quote: func(name string) string { return "`" + name + "`" },
+		types: map[string]string{
+			"int": "BIGINT", "int64": "BIGINT", "float64": "DOUBLE", "bool": "BOOLEAN",
+			"string": "TEXT", "date-time": "DATETIME", "date": "DATE", "uuid": "CHAR(36)", "json": "JSON",
+			"key": "VARCHAR(255)",
+		},
+		serial: "BIGINT AUTO_INCREMENT",
+	},
+	"sqlite": {
+		quote: func(name string) string { return `"` + name + `"` },
+		types: map[string]string{
+			"int": "INTEGER", "int64": "INTEGER", "float64": "REAL", "bool": "INTEGER",
+			"string": "TEXT", "date-time": "TEXT", "date": "TEXT", "uuid": "TEXT", "json": "TEXT",
+		},
+		serial: "INTEGER",
+	},
+}
+
+type sqlColumn struct {
+	Name       string
+	Type       string
+	NotNull    bool
+	PrimaryKey bool
+}
+
+type sqlForeignKey struct {
+	Column   string
+	RefTable string
+	RefKey   string
+}
+
+type sqlTable struct {
+	Name        string
+	Columns     []sqlColumn
+	ForeignKeys []sqlForeignKey
+	KeyType     string
+	dependsOn   []string
+}
+
+// addColumn appends column, numbering its name when the table already has
+// a column of that name, such as a field "order_id" next to the foreign key
+// to the parent table "order". It returns the name used.
+func (t *sqlTable) addColumn(column sqlColumn) string {
+	name := column.Name
+	for n := 2; t.hasColumn(column.Name); n++ {
+		column.Name = fmt.Sprintf("%s_%d", name, n)
+	}
+	t.Columns = append(t.Columns, column)
+	return column.Name
+}
+
+func (t *sqlTable) hasColumn(name string) bool {
+	for _, column := range t.Columns {
+		if column.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+type sqlBuilder struct {
+	dialect  sqlDialect
+	flatten  bool
+	classes  map[string]models.ClassDefinition
+	tables   map[string]*sqlTable
+	order    []string
+	visiting map[string]bool
+}
+
+func NewSQLGenerator() *SQLGenerator {
+	return &SQLGenerator{
+		template: template.Must(template.New("sql").Funcs(getSQLTemplateFuncs()).Parse(sqlTemplate)),
+	}
+}
+
+func (s *SQLGenerator) GetName() string {
+	return "sql"
+}
+
+func (s *SQLGenerator) GetFileExtension() string {
+	return "sql"
+}
+
+func (s *SQLGenerator) TemplateFuncs() template.FuncMap {
+	return getSQLTemplateFuncs()
+}
+
+func (s *SQLGenerator) Generate(classes []models.ClassDefinition) (string, error) {
+	return s.GenerateWithOptions(classes, nil)
+}
+
+func (s *SQLGenerator) GenerateWithOptions(classes []models.ClassDefinition, opts models.Options) (string, error) {
+	dialectName := opts.Get("dialect", "postgres")
+	dialect, ok := sqlDialects[dialectName]
+	if !ok {
+		return "", fmt.Errorf("sql dialect '%s' not supported", dialectName)
+	}
+
+	nested := opts.Get("nested", "tables")
+	if nested != "tables" && nested != "flatten" {
+		return "", fmt.Errorf("sql nested mode '%s' not supported, expected tables or flatten", nested)
+	}
+
+	builder := &sqlBuilder{
+		dialect:  dialect,
+		flatten:  nested == "flatten",
+		classes:  make(map[string]models.ClassDefinition),
+		tables:   make(map[string]*sqlTable),
+		visiting: make(map[string]bool),
+	}
+	for _, class := range classes {
+		builder.classes[class.Name] = class
+	}
+	for _, root := range rootClasses(classes) {
+		builder.table(root, nil)
+	}
+
+	var buf strings.Builder
+	if err := s.template.Execute(&buf, map[string]interface{}{
+		"Classes": classes,
+		"Options": opts,
+		"Tables":  builder.sortedTables(),
+		"Quote":   dialect.quote,
+	}); err != nil {
+		return "", fmt.Errorf("failed to execute template for %s: %w", s.GetName(), err)
+	}
+
+	return buf.String(), nil
+}
+
+// table returns the table for a class, creating it on first use. When
+// parent is set, the class is the element of a list and the table gets a
+// foreign key back to the parent row.
+func (b *sqlBuilder) table(class models.ClassDefinition, parent *sqlTable) *sqlTable {
+	name := conventions.ToSnakeCase(class.Name)
+	table, exists := b.tables[name]
+	if !exists {
+		table = &sqlTable{Name: name}
+		b.tables[name] = table
+		b.order = append(b.order, name)
+		b.addPrimaryKey(table, class)
+		b.addColumns(table, class, "", true)
+	}
+
+	if parent != nil {
+		column := table.addColumn(sqlColumn{Name: parent.Name + "_id", Type: parent.KeyType, NotNull: true})
+		table.ForeignKeys = append(table.ForeignKeys, sqlForeignKey{Column: column, RefTable: parent.Name, RefKey: "id"})
+		table.dependsOn = append(table.dependsOn, parent.Name)
+	}
+	return table
+}
+
+func (b *sqlBuilder) addPrimaryKey(table *sqlTable, class models.ClassDefinition) {
+	for _, field := range class.Fields {
+		if field.JSONTag == "id" && isPrimitiveType(field.TypeName) && !field.IsList && !field.IsMap && field.TypeName != "interface{}" {
+			table.KeyType = b.columnType(field)
+			if key, ok := b.dialect.types["key"]; ok && table.KeyType == b.dialect.types["string"] {
+				table.KeyType = key
+			}
+			table.Columns = append(table.Columns, sqlColumn{Name: "id", Type: table.KeyType, NotNull: true, PrimaryKey: true})
+			return
+		}
+	}
+
+	table.KeyType = b.dialect.types["int"]
+	table.Columns = append(table.Columns, sqlColumn{Name: "id", Type: b.dialect.serial, NotNull: true, PrimaryKey: true})
+}
+
+func (b *sqlBuilder) addColumns(table *sqlTable, class models.ClassDefinition, prefix string, required bool) {
+	b.visiting[class.Name] = true
+	defer delete(b.visiting, class.Name)
+
+	for _, field := range class.Fields {
+		column := prefix + conventions.ToSnakeCase(field.Name)
+		if prefix == "" && column == "id" {
+			continue
+		}
+		notNull := required && !field.IsOptional
+		child, isClass := b.classes[field.TypeName]
+
+		switch {
+		case isClass && field.IsList && !field.IsMap:
+			b.table(child, table)
+
+		case isClass && !field.IsList && !field.IsMap && b.flatten && !b.visiting[child.Name]:
+			b.addColumns(table, child, column+"_", notNull)
+
+		case isClass && !field.IsList && !field.IsMap && !b.flatten:
+			childTable := b.table(child, nil)
+			column = table.addColumn(sqlColumn{Name: column + "_id", Type: childTable.KeyType, NotNull: notNull})
+			table.ForeignKeys = append(table.ForeignKeys, sqlForeignKey{Column: column, RefTable: childTable.Name, RefKey: "id"})
+			table.dependsOn = append(table.dependsOn, childTable.Name)
+
+		default:
+			table.addColumn(sqlColumn{Name: column, Type: b.columnType(field), NotNull: notNull})
+		}
+	}
+}
+
+func (b *sqlBuilder) columnType(field models.FieldDefinition) string {
+	if field.IsList || field.IsMap || !isPrimitiveType(field.TypeName) || field.TypeName == "interface{}" {
+		return b.dialect.types["json"]
+	}
+	if field.TypeName == "string" {
+		if formatType, ok := b.dialect.types[field.Format]; ok && field.Format != "" {
+			return formatType
+		}
+	}
+	return b.dialect.types[field.TypeName]
+}
+
+// sortedTables orders tables so that every table is created after the
+// tables its foreign keys reference.
+func (b *sqlBuilder) sortedTables() []*sqlTable {
+	sorted := []*sqlTable{}
+	state := make(map[string]int)
+
+	var visit func(name string)
+	visit = func(name string) {
+		if state[name] != 0 {
+			return
+		}
+		state[name] = 1
+		for _, dependency := range b.tables[name].dependsOn {
+			visit(dependency)
+		}
+		state[name] = 2
+		sorted = append(sorted, b.tables[name])
+	}
+
+	for _, name := range b.order {
+		visit(name)
+	}
+	return sorted
+}
+
+func getSQLTemplateFuncs() template.FuncMap {
+	// Templates that override sql get the column types of the default
+	// dialect.
+	builder := &sqlBuilder{dialect: sqlDialects["postgres"]}
+	return template.FuncMap{
+		"convertType": func(typeName string) string {
+			return builder.columnType(models.FieldDefinition{TypeName: typeName})
+		},
+		"formatType":   builder.columnType,
+		"formatColumn": formatSQLColumn,
+		"last": func(index int, table *sqlTable) bool {
+			return index == len(table.Columns)+len(table.ForeignKeys)-1
+		},
+		"add": func(a, b int) int { return a + b },
+	}
+}
+
+func formatSQLColumn(column sqlColumn, quote func(string) string) string {
+	var columnBuilder strings.Builder
+	columnBuilder.WriteString(quote(column.Name) + " " + column.Type)
+	if column.PrimaryKey {
+		columnBuilder.WriteString(" PRIMARY KEY")
+	} else if column.NotNull {
+		columnBuilder.WriteString(" NOT NULL")
+	}
+	return columnBuilder.String()
+}
+
+// rootClasses returns the classes that no other class refers to, in their
+// original order. If every class is referenced the last one is the root,
+// which is where the parser places the top-level object.
+func rootClasses(classes []models.ClassDefinition) []models.ClassDefinition {
+	referenced := make(map[string]bool)
+	for _, class := range classes {
+		for _, field := range class.Fields {
+			if field.TypeName != class.Name {
+				referenced[field.TypeName] = true
+			}
+		}
+	}
+
+	roots := []models.ClassDefinition{}
+	for _, class := range classes {
+		if !referenced[class.Name] {
+			roots = append(roots, class)
+		}
+	}
+	if len(roots) == 0 && len(classes) > 0 {
+		roots = append(roots, classes[len(classes)-1])
+	}
+	return roots
+}
+
+var sqlTemplate = `
+{{- range $table := .Tables }}
+CREATE TABLE {{ call $.Quote .Name }} (
+{{- range $index, $column := .Columns }}
+  {{ formatColumn $column $.Quote }}{{ if not (last $index $table) }},{{ end }}
+{{- end }}
+{{- range $index, $key := .ForeignKeys }}
+  FOREIGN KEY ({{ call $.Quote .Column }}) REFERENCES {{ call $.Quote .RefTable }} ({{ call $.Quote .RefKey }}){{ if not (last (add $index (len $table.Columns)) $table) }},{{ end }}
+{{- end }}
+);
+{{ end }}`
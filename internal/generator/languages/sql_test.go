@@ -0,0 +1,179 @@
+package languages
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/jguerreno/JSON-Converter/internal/models"
+)
+
+var sqlTestClasses = []models.ClassDefinition{
+	{
+		Name: "Address",
+		Fields: []models.FieldDefinition{
+			{Name: "City", JSONTag: "city", TypeName: "string"},
+		},
+	},
+	{
+		Name: "LinesItem",
+		Fields: []models.FieldDefinition{
+			{Name: "Sku", JSONTag: "sku", TypeName: "string"},
+			{Name: "Qty", JSONTag: "qty", TypeName: "int"},
+		},
+	},
+	{
+		Name: "Order",
+		Fields: []models.FieldDefinition{
+			{Name: "Id", JSONTag: "id", TypeName: "string", Format: "uuid"},
+			{Name: "CreatedAt", JSONTag: "created_at", TypeName: "string", Format: "date-time"},
+			{Name: "Total", JSONTag: "total", TypeName: "float64"},
+			{Name: "Note", JSONTag: "note", TypeName: "string", IsOptional: true},
+			{Name: "Address", JSONTag: "address", TypeName: "Address", IsOptional: true},
+			{Name: "Lines", JSONTag: "lines", TypeName: "LinesItem", IsList: true},
+			{Name: "Meta", JSONTag: "meta", TypeName: "interface{}"},
+		},
+	},
+}
+
+func TestSQLColumnType(t *testing.T) {
+	tests := []struct {
+		dialect string
+		field   models.FieldDefinition
+		want    string
+	}{
+		{"postgres", models.FieldDefinition{TypeName: "int"}, "BIGINT"},
+		{"postgres", models.FieldDefinition{TypeName: "float64"}, "DOUBLE PRECISION"},
+		{"postgres", models.FieldDefinition{TypeName: "string", Format: "date-time"}, "TIMESTAMPTZ"},
+		{"postgres", models.FieldDefinition{TypeName: "string", Format: "uuid"}, "UUID"},
+		{"postgres", models.FieldDefinition{TypeName: "interface{}"}, "JSONB"},
+		{"postgres", models.FieldDefinition{TypeName: "string", IsList: true}, "JSONB"},
+		{"mysql", models.FieldDefinition{TypeName: "float64"}, "DOUBLE"},
+		{"mysql", models.FieldDefinition{TypeName: "string", Format: "date-time"}, "DATETIME"},
+		{"mysql", models.FieldDefinition{TypeName: "interface{}"}, "JSON"},
+		{"sqlite", models.FieldDefinition{TypeName: "bool"}, "INTEGER"},
+		{"sqlite", models.FieldDefinition{TypeName: "float64"}, "REAL"},
+	}
+
+	for _, tt := range tests {
+		builder := &sqlBuilder{dialect: sqlDialects[tt.dialect]}
+		result := builder.columnType(tt.field)
+		if result != tt.want {
+			t.Errorf("columnType(%s, %v) = %q, want %q", tt.dialect, tt.field, result, tt.want)
+		}
+	}
+}
+
+func TestGenerateSQLTables(t *testing.T) {
+	code, err := NewSQLGenerator().Generate(sqlTestClasses)
+	if err != nil {
+		t.Fatalf("GenerateSQL failed: %v", err)
+	}
+
+	expectedCode := []string{
+		`CREATE TABLE "order" (`,
+		`"id" UUID PRIMARY KEY`,
+		`"created_at" TIMESTAMPTZ NOT NULL`,
+		`"total" DOUBLE PRECISION NOT NULL`,
+		`"note" TEXT,`,
+		`"address_id" BIGINT,`,
+		`"meta" JSONB NOT NULL`,
+		`FOREIGN KEY ("address_id") REFERENCES "address" ("id")`,
+		`CREATE TABLE "address" (`,
+		`"id" BIGSERIAL PRIMARY KEY`,
+		`CREATE TABLE "lines_item" (`,
+		`"order_id" UUID NOT NULL`,
+		`FOREIGN KEY ("order_id") REFERENCES "order" ("id")`,
+	}
+
+	for _, expected := range expectedCode {
+		if !strings.Contains(code, expected) {
+			t.Errorf("Generated SQL code missing: %q", expected)
+		}
+	}
+
+	address := strings.Index(code, `CREATE TABLE "address"`)
+	order := strings.Index(code, `CREATE TABLE "order"`)
+	lines := strings.Index(code, `CREATE TABLE "lines_item"`)
+	if !(address < order && order < lines) {
+		t.Errorf("tables must be created before the tables that reference them, got:\n%s", code)
+	}
+}
+
+func TestGenerateSQLForeignKeyNameCollision(t *testing.T) {
+	// {"address": {...}, "address_id": 1, "lines": [{"id": 5, "sku": "a", "root_id": 3}]}
+	classes := []models.ClassDefinition{
+		{Name: "Address", Fields: []models.FieldDefinition{{Name: "City", JSONTag: "city", TypeName: "string"}}},
+		{
+			Name: "LinesItem",
+			Fields: []models.FieldDefinition{
+				{Name: "Id", JSONTag: "id", TypeName: "int"},
+				{Name: "Sku", JSONTag: "sku", TypeName: "string"},
+				{Name: "RootId", JSONTag: "root_id", TypeName: "int"},
+			},
+		},
+		{
+			Name: "Root",
+			Fields: []models.FieldDefinition{
+				{Name: "Address", JSONTag: "address", TypeName: "Address"},
+				{Name: "AddressId", JSONTag: "address_id", TypeName: "int"},
+				{Name: "Lines", JSONTag: "lines", TypeName: "LinesItem", IsList: true},
+			},
+		},
+	}
+
+	code, err := NewSQLGenerator().Generate(classes)
+	if err != nil {
+		t.Fatalf("GenerateSQL failed: %v", err)
+	}
+
+	expectedCode := []string{
+		`"address_id" BIGINT NOT NULL,`,
+		`"address_id_2" BIGINT NOT NULL,`,
+		`FOREIGN KEY ("address_id") REFERENCES "address" ("id")`,
+		`"root_id" BIGINT NOT NULL,`,
+		`"root_id_2" BIGINT NOT NULL,`,
+		`FOREIGN KEY ("root_id_2") REFERENCES "root" ("id")`,
+	}
+	for _, expected := range expectedCode {
+		if !strings.Contains(code, expected) {
+			t.Errorf("Generated SQL code missing: %q\n%s", expected, code)
+		}
+	}
+}
+
+func TestGenerateSQLFlattenMySQL(t *testing.T) {
+	code, err := NewSQLGenerator().GenerateWithOptions(sqlTestClasses, models.Options{
+		"dialect": "mysql",
+		"nested":  "flatten",
+	})
+	if err != nil {
+		t.Fatalf("GenerateSQL failed: %v", err)
+	}
+
+	expectedCode := []string{
+		"CREATE TABLE `order` (",
+		"`id` CHAR(36) PRIMARY KEY",
+		"`address_city` TEXT,",
+		"CREATE TABLE `lines_item` (",
+		"`id` BIGINT AUTO_INCREMENT PRIMARY KEY",
+		"FOREIGN KEY (`order_id`) REFERENCES `order` (`id`)",
+	}
+
+	for _, expected := range expectedCode {
+		if !strings.Contains(code, expected) {
+			t.Errorf("Generated SQL code missing: %q", expected)
+		}
+	}
+	if strings.Contains(code, "CREATE TABLE `address`") {
+		t.Error("flattened objects should not get their own table")
+	}
+}
+
+func TestGenerateSQLInvalidOptions(t *testing.T) {
+	if _, err := NewSQLGenerator().GenerateWithOptions(sqlTestClasses, models.Options{"dialect": "oracle"}); err == nil {
+		t.Error("expected error for unsupported dialect")
+	}
+	if _, err := NewSQLGenerator().GenerateWithOptions(sqlTestClasses, models.Options{"nested": "inline"}); err == nil {
+		t.Error("expected error for unsupported nested mode")
+	}
+}
@@ -0,0 +1,149 @@
+package languages
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/jguerreno/JSON-Converter/internal/conventions"
+	"github.com/jguerreno/JSON-Converter/internal/models"
+)
+
+// TemplateConfig is the type mapping that accompanies a user template. It
+// is read from a JSON file next to the template:
+//
+//	{
+//	  "extension": "kt",
+//	  "types": {"string": "String", "int": "Int", "int64": "Long",
+//	            "float64": "Double", "bool": "Boolean", "interface{}": "Any"},
+//	  "list": "List<%s>",
+//	  "map": "Map<String, %s>",
+//	  "optional": "%s?"
+//	}
+//
+// Keys of "types" are the parsed type names; class names that are not
+// mapped are used as they are. "list", "map" and "optional" are fmt
+// patterns wrapped around the converted type.
+type TemplateConfig struct {
+	Name      string            `json:"name"`
+	Extension string            `json:"extension"`
+	Types     map[string]string `json:"types"`
+	List      string            `json:"list"`
+	Map       string            `json:"map"`
+	Optional  string            `json:"optional"`
+}
+
+// TemplateData is the value user templates are executed with.
+type TemplateData struct {
+	// Classes are ordered so that every class follows the classes its
+	// fields refer to.
+	Classes []models.ClassDefinition
+	// Options holds the -opt key=value pairs.
+	Options models.Options
+	// Language is the name the template is registered under.
+	Language string
+}
+
+// TemplateGenerator renders a user supplied template, either in place of a
+// built-in language or as a new one.
+type TemplateGenerator struct {
+	name      string
+	extension string
+	template  *template.Template
+}
+
+// NewTemplateGenerator parses text with the generic helpers (casing,
+// convertType and formatType from config) plus funcs, which take precedence
+// so that a template overriding a built-in language keeps its helpers.
+//
+// Every template can call convertType with a parsed type name and
+// formatType with a models.FieldDefinition, both returning the type in the
+// target language. They come from funcs unless config maps types itself.
+func NewTemplateGenerator(config TemplateConfig, text string, funcs template.FuncMap) (*TemplateGenerator, error) {
+	helpers := getTemplateHelperFuncs()
+	helpers["convertType"] = config.convertType
+	helpers["formatType"] = config.formatType
+	for name, fn := range funcs {
+		if config.Types != nil && (name == "convertType" || name == "formatType") {
+			continue
+		}
+		helpers[name] = fn
+	}
+
+	tmpl, err := template.New(config.Name).Funcs(helpers).Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse template for %s: %w", config.Name, err)
+	}
+
+	return &TemplateGenerator{
+		name:      config.Name,
+		extension: config.Extension,
+		template:  tmpl,
+	}, nil
+}
+
+func (t *TemplateGenerator) GetName() string {
+	return t.name
+}
+
+func (t *TemplateGenerator) GetFileExtension() string {
+	return t.extension
+}
+
+func (t *TemplateGenerator) Generate(classes []models.ClassDefinition) (string, error) {
+	return t.GenerateWithOptions(classes, nil)
+}
+
+func (t *TemplateGenerator) GenerateWithOptions(classes []models.ClassDefinition, opts models.Options) (string, error) {
+	var buf strings.Builder
+	if err := t.template.Execute(&buf, TemplateData{
+		Classes:  sortByDependency(classes),
+		Options:  opts,
+		Language: t.name,
+	}); err != nil {
+		return "", fmt.Errorf("failed to execute template for %s: %w", t.GetName(), err)
+	}
+
+	return buf.String(), nil
+}
+
+func (c TemplateConfig) convertType(typeName string) string {
+	if mapped, ok := c.Types[typeName]; ok {
+		return mapped
+	}
+	return typeName
+}
+
+func (c TemplateConfig) formatType(field models.FieldDefinition) string {
+	typeName := c.convertType(field.TypeName)
+	switch {
+	case field.IsMap:
+		typeName = applyPattern(c.Map, typeName)
+	case field.IsOptional && !field.IsList:
+		typeName = applyPattern(c.Optional, typeName)
+	}
+	if field.IsList {
+		typeName = applyPattern(c.List, typeName)
+	}
+	return typeName
+}
+
+func applyPattern(pattern, typeName string) string {
+	if pattern == "" {
+		return typeName
+	}
+	return fmt.Sprintf(pattern, typeName)
+}
+
+func getTemplateHelperFuncs() template.FuncMap {
+	return template.FuncMap{
+		"pascalCase": conventions.ToPascalCase,
+		"camelCase":  conventions.ToCamelCase,
+		"snakeCase":  conventions.ToSnakeCase,
+		"lower":      strings.ToLower,
+		"upper":      strings.ToUpper,
+		"join":       strings.Join,
+		"fieldDoc":   formatFieldDoc,
+		"classDoc":   formatClassDoc,
+	}
+}
@@ -0,0 +1,57 @@
+package languages
+
+import (
+	"testing"
+
+	"github.com/jguerreno/JSON-Converter/internal/models"
+)
+
+func TestTemplateConfigFormatType(t *testing.T) {
+	config := TemplateConfig{
+		Types:    map[string]string{"string": "String", "int": "Int"},
+		List:     "List<%s>",
+		Map:      "Map<String, %s>",
+		Optional: "%s?",
+	}
+
+	tests := []struct {
+		field models.FieldDefinition
+		want  string
+	}{
+		{models.FieldDefinition{TypeName: "string"}, "String"},
+		{models.FieldDefinition{TypeName: "int", IsOptional: true}, "Int?"},
+		{models.FieldDefinition{TypeName: "Address", IsList: true, IsOptional: true}, "List<Address>"},
+		{models.FieldDefinition{TypeName: "int", IsMap: true, IsOptional: true}, "Map<String, Int>"},
+		{models.FieldDefinition{TypeName: "bool"}, "bool"},
+	}
+
+	for _, tt := range tests {
+		result := config.formatType(tt.field)
+		if result != tt.want {
+			t.Errorf("formatType(%v) = %q, want %q", tt.field, result, tt.want)
+		}
+	}
+}
+
+func TestGenerateTemplateOverride(t *testing.T) {
+	gen, err := NewTemplateGenerator(
+		TemplateConfig{Name: "python", Extension: "py"},
+		`{{ .Language }}:{{ range .Classes }} {{ .Name }}({{ range .Fields }}{{ fieldName . }}: {{ formatType . }}{{ end }}){{ end }}`,
+		NewPythonGenerator().TemplateFuncs(),
+	)
+	if err != nil {
+		t.Fatalf("NewTemplateGenerator failed: %v", err)
+	}
+
+	classes := []models.ClassDefinition{
+		{Name: "Order", Fields: []models.FieldDefinition{{Name: "Customer", TypeName: "Customer", IsOptional: true}}},
+		{Name: "Customer", Fields: []models.FieldDefinition{{Name: "FullName", TypeName: "string"}}},
+	}
+	code, err := gen.Generate(classes)
+	if err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+	if want := "python: Customer(full_name: str) Order(customer: Optional[Customer])"; code != want {
+		t.Errorf("Generate() = %q, want %q", code, want)
+	}
+}
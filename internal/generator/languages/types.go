@@ -0,0 +1,67 @@
+package languages
+
+import "github.com/jguerreno/JSON-Converter/internal/models"
+
+// isPrimitiveType reports whether a parsed type name is one of the built-in
+// primitives rather than the name of a generated class.
+func isPrimitiveType(typeName string) bool {
+	switch typeName {
+	case "string", "int", "int64", "float64", "bool", "interface{}":
+		return true
+	default:
+		return false
+	}
+}
+
+func usesType(classes []models.ClassDefinition, match func(models.FieldDefinition) bool) bool {
+	for _, class := range classes {
+		for _, field := range class.Fields {
+			if match(field) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// sortByDependency orders classes so that every class comes after the
+// classes its fields refer to. Classes that are part of a cycle keep their
+// relative order, and one of them necessarily refers forward.
+func sortByDependency(classes []models.ClassDefinition) []models.ClassDefinition {
+	byName := make(map[string]models.ClassDefinition)
+	for _, class := range classes {
+		byName[class.Name] = class
+	}
+
+	sorted := make([]models.ClassDefinition, 0, len(classes))
+	visited := make(map[string]bool)
+
+	var visit func(class models.ClassDefinition)
+	visit = func(class models.ClassDefinition) {
+		if visited[class.Name] {
+			return
+		}
+		visited[class.Name] = true
+		for _, field := range class.Fields {
+			if dependency, ok := byName[field.TypeName]; ok {
+				visit(dependency)
+			}
+		}
+		sorted = append(sorted, class)
+	}
+
+	for _, class := range classes {
+		visit(class)
+	}
+	return sorted
+}
+
+// classPositions maps each class name to its index, so templates can tell
+// whether a referenced class has already been declared.
+func classPositions(classes []models.ClassDefinition) map[string]int {
+	positions := make(map[string]int, len(classes))
+	for i, class := range classes {
+		positions[class.Name] = i
+	}
+	return positions
+}
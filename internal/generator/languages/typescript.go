@@ -2,26 +2,58 @@ package languages
 
 import (
 	"fmt"
+	"regexp"
+	"strconv"
 	"strings"
 	"text/template"
 
 	"github.com/jguerreno/JSON-Converter/internal/models"
 )
 
+var typeScriptIdentifier = regexp.MustCompile(`^[A-Za-z_$][A-Za-z0-9_$]*$`)
+
+// TypeScriptGenerator emits plain interfaces by default. The "style" option
+// selects runtime validators instead: "zod" schemas or "io-ts" codecs, each
+// with a matching static type. With the "codec" option the interface style
+// also gets fromJson/toJson functions that check payloads at runtime, and
+// "docs" adds TSDoc comments with the JSON path and example values.
 type TypeScriptGenerator struct {
-	template *template.Template
+	templates map[string]*template.Template
 }
 
 func NewTypeScriptGenerator() *TypeScriptGenerator {
 	return &TypeScriptGenerator{
-		template: template.Must(template.New("typescript").Funcs(getTemplateFuncs()).Parse(typescriptTemplate)),
+		templates: map[string]*template.Template{
+			"interface": template.Must(template.Must(template.New("typescript").Funcs(getTemplateFuncs()).Parse(typescriptTemplate)).Parse(typescriptCodecTemplate)),
+			"zod":       template.Must(template.New("zod").Funcs(getTemplateFuncs()).Parse(zodTemplate)),
+			"io-ts":     template.Must(template.New("io-ts").Funcs(getTemplateFuncs()).Parse(ioTSTemplate)),
+		},
 	}
 }
 
 func (t *TypeScriptGenerator) Generate(classes []models.ClassDefinition) (string, error) {
+	return t.GenerateWithOptions(classes, nil)
+}
+
+func (t *TypeScriptGenerator) GenerateWithOptions(classes []models.ClassDefinition, opts models.Options) (string, error) {
+	style := opts.Get("style", "interface")
+	tmpl, ok := t.templates[style]
+	if !ok {
+		return "", fmt.Errorf("typescript style '%s' not supported", style)
+	}
+
+	// Validators are values, so a schema must be declared before it is used.
+	sorted := sortByDependency(classes)
+
 	var buf strings.Builder
-	if err := t.template.Execute(&buf, map[string]interface{}{
-		"Classes": classes,
+	if err := tmpl.Execute(&buf, map[string]interface{}{
+		"Classes":   sorted,
+		"Options":   opts,
+		"Positions": classPositions(sorted),
+		"Lazy":      lazyClasses(sorted),
+		"Codec":     opts.Bool("codec"),
+		"Helpers":   typeScriptCodecHelpers(sorted),
+		"Docs":      opts.Bool("docs"),
 	}); err != nil {
 		return "", fmt.Errorf("failed to execute template for %s: %w", t.GetName(), err)
 	}
@@ -37,6 +69,10 @@ func (t *TypeScriptGenerator) GetFileExtension() string {
 	return "ts"
 }
 
+func (t *TypeScriptGenerator) TemplateFuncs() template.FuncMap {
+	return getTemplateFuncs()
+}
+
 func convertTypeScriptType(goType string) string {
 	switch goType {
 	case "string":
@@ -54,24 +90,236 @@ func convertTypeScriptType(goType string) string {
 
 func getTemplateFuncs() template.FuncMap {
 	return template.FuncMap{
-		"convertType": formatTypeScriptType,
+		"convertType":  convertTypeScriptType,
+		"formatType":   formatTypeScriptType,
+		"propertyName": formatTypeScriptProperty,
+		"zodType":      formatZodType,
+		"ioTSType":     formatIOTSType,
+		"hasRequired":  func(class models.ClassDefinition) bool { return hasOptionalFields(class, false) },
+		"hasOptional":  func(class models.ClassDefinition) bool { return hasOptionalFields(class, true) },
+		"codecName":    formatTypeScriptCodecName,
+		"fromJson":     formatTypeScriptFromJson,
+		"toJson":       formatTypeScriptToJson,
+		"requiredKeys": requiredJSONKeys,
+		"fieldDoc":     formatFieldDoc,
+		"classDoc":     formatClassDoc,
+		"blockDoc":     formatBlockDoc,
 	}
 }
 
 func formatTypeScriptType(field models.FieldDefinition) string {
 	typeBuilder := strings.Builder{}
-	typeBuilder.WriteString(convertTypeScriptType(field.TypeName))
+	if field.IsMap {
+		typeBuilder.WriteString("Record<string, ")
+	}
+	if len(field.Enum) > 0 && field.TypeName == "string" {
+		literals := quoteEnum(field.Enum, " | ")
+		if field.IsList {
+			literals = "(" + literals + ")"
+		}
+		typeBuilder.WriteString(literals)
+	} else {
+		typeBuilder.WriteString(convertTypeScriptType(field.TypeName))
+	}
+	if field.IsMap {
+		typeBuilder.WriteString(">")
+	}
 	if field.IsList {
 		typeBuilder.WriteString("[]")
 	}
 	return typeBuilder.String()
 }
 
+// formatTypeScriptProperty quotes JSON keys that are not valid identifiers.
+func formatTypeScriptProperty(field models.FieldDefinition) string {
+	if typeScriptIdentifier.MatchString(field.JSONTag) {
+		return field.JSONTag
+	}
+	return strconv.Quote(field.JSONTag)
+}
+
+func formatZodType(field models.FieldDefinition, positions map[string]int, index int) string {
+	var valueType string
+	switch {
+	case len(field.Enum) > 0 && field.TypeName == "string":
+		valueType = "z.enum([" + quoteEnum(field.Enum, ", ") + "])"
+	case field.TypeName == "string":
+		valueType = "z.string()"
+		switch field.Format {
+		case "date-time":
+			valueType += ".datetime({ offset: true })"
+		case "date":
+			valueType += ".date()"
+		case "uuid":
+			valueType += ".uuid()"
+		}
+	case field.TypeName == "int" || field.TypeName == "int64":
+		valueType = "z.number().int()"
+	case field.TypeName == "float64":
+		valueType = "z.number()"
+	case field.TypeName == "bool":
+		valueType = "z.boolean()"
+	case field.TypeName == "interface{}":
+		valueType = "z.any()"
+	default:
+		valueType = field.TypeName + "Schema"
+		if position, ok := positions[field.TypeName]; ok && position >= index {
+			valueType = "z.lazy(() => " + valueType + ")"
+		}
+	}
+
+	if field.IsMap {
+		valueType = "z.record(z.string(), " + valueType + ")"
+	}
+	if field.IsList {
+		valueType = "z.array(" + valueType + ")"
+	}
+	// The parser marks a field optional when it is missing or null in some
+	// sample, so both must pass.
+	if field.IsOptional {
+		valueType += ".nullish()"
+	}
+	return valueType
+}
+
+func formatIOTSType(field models.FieldDefinition, positions map[string]int, index int) string {
+	var valueType string
+	switch {
+	case len(field.Enum) > 0 && field.TypeName == "string":
+		keys := make([]string, len(field.Enum))
+		for i, value := range field.Enum {
+			keys[i] = strconv.Quote(value) + ": null"
+		}
+		valueType = "t.keyof({ " + strings.Join(keys, ", ") + " })"
+	case field.TypeName == "string":
+		valueType = "t.string"
+	case field.TypeName == "int" || field.TypeName == "int64" || field.TypeName == "float64":
+		valueType = "t.number"
+	case field.TypeName == "bool":
+		valueType = "t.boolean"
+	case field.TypeName == "interface{}":
+		valueType = "t.unknown"
+	default:
+		valueType = field.TypeName
+		if position, ok := positions[field.TypeName]; ok && position >= index {
+			// io-ts needs t.recursion with an explicit type for cycles, so
+			// a reference back up the cycle is left unchecked.
+			valueType = "t.unknown"
+		}
+	}
+
+	if field.IsMap {
+		valueType = "t.record(t.string, " + valueType + ")"
+	}
+	if field.IsList {
+		valueType = "t.array(" + valueType + ")"
+	}
+	// Optional fields go in t.partial, which allows them to be missing but
+	// not null.
+	if field.IsOptional {
+		valueType = "t.union([" + valueType + ", t.null])"
+	}
+	return valueType
+}
+
+// lazyClasses returns the classes referred to before they are declared,
+// through z.lazy. TypeScript cannot infer the type of a schema on such a
+// cycle, so theirs is declared as an interface.
+func lazyClasses(classes []models.ClassDefinition) map[string]bool {
+	positions := classPositions(classes)
+	lazy := map[string]bool{}
+	for index, class := range classes {
+		for _, field := range class.Fields {
+			if position, ok := positions[field.TypeName]; ok && position >= index {
+				lazy[field.TypeName] = true
+			}
+		}
+	}
+	return lazy
+}
+
+func hasOptionalFields(class models.ClassDefinition, optional bool) bool {
+	for _, field := range class.Fields {
+		if field.IsOptional == optional {
+			return true
+		}
+	}
+	return false
+}
+
+func quoteEnum(values []string, separator string) string {
+	quoted := make([]string, len(values))
+	for i, value := range values {
+		quoted[i] = strconv.Quote(value)
+	}
+	return strings.Join(quoted, separator)
+}
+
 var typescriptTemplate = `
 {{ range .Classes }}
+{{- if $.Docs }}{{ with classDoc . }}
+/** {{ blockDoc . }} */
+{{- end }}{{ end }}
 export interface {{.Name}} {
 {{- range .Fields }}
-  {{.JSONTag}}{{if .IsOptional}}?{{end}}: {{ convertType .}};
+{{- if $.Docs }}{{ with fieldDoc . }}
+  /** {{ blockDoc . }} */
+{{- end }}{{ end }}
+  {{ propertyName . }}{{if .IsOptional}}?{{end}}: {{ formatType . }};
+{{- end }}
+}
+{{ end }}
+{{- if .Codec }}{{ template "codec" . }}{{ end }}`
+
+var zodTemplate = `import { z } from "zod";
+{{ range $index, $class := .Classes }}
+{{- $lazy := index $.Lazy .Name }}
+{{- if $lazy }}
+export interface {{ .Name }} {
+{{- range .Fields }}
+  {{ propertyName . }}{{ if .IsOptional }}?{{ end }}: {{ formatType . }}{{ if .IsOptional }} | null{{ end }};
 {{- end }}
 }
+{{ end }}
+{{- if $.Docs }}{{ with classDoc . }}
+/** {{ blockDoc . }} */
+{{- end }}{{ end }}
+export const {{ .Name }}Schema{{ if $lazy }}: z.ZodType<{{ .Name }}>{{ end }} = z.object({
+{{- range .Fields }}
+{{- if $.Docs }}{{ with fieldDoc . }}
+  /** {{ blockDoc . }} */
+{{- end }}{{ end }}
+  {{ propertyName . }}: {{ zodType . $.Positions $index }},
+{{- end }}
+});
+{{- if not $lazy }}
+export type {{ .Name }} = z.infer<typeof {{ .Name }}Schema>;
+{{- end }}
+{{ end }}`
+
+var ioTSTemplate = `import * as t from "io-ts";
+{{ range $index, $class := .Classes }}
+{{- $both := and (hasRequired $class) (hasOptional $class) }}
+{{- if $.Docs }}{{ with classDoc . }}
+/** {{ blockDoc . }} */
+{{- end }}{{ end }}
+export const {{ .Name }} = {{ if $both }}t.intersection([
+  {{ end }}{{ if or (hasRequired $class) (not (hasOptional $class)) }}t.type({
+{{- range .Fields }}{{ if not .IsOptional }}
+{{- if $.Docs }}{{ with fieldDoc . }}
+  {{ if $both }}  {{ end }}/** {{ blockDoc . }} */
+{{- end }}{{ end }}
+  {{ if $both }}  {{ end }}{{ propertyName . }}: {{ ioTSType . $.Positions $index }},
+{{- end }}{{ end }}
+{{ if $both }}  {{ end }}}){{ end }}{{ if $both }},
+  {{ end }}{{ if hasOptional $class }}t.partial({
+{{- range .Fields }}{{ if .IsOptional }}
+{{- if $.Docs }}{{ with fieldDoc . }}
+  {{ if $both }}  {{ end }}/** {{ blockDoc . }} */
+{{- end }}{{ end }}
+  {{ if $both }}  {{ end }}{{ propertyName . }}: {{ ioTSType . $.Positions $index }},
+{{- end }}{{ end }}
+{{ if $both }}  {{ end }}}){{ end }}{{ if $both }},
+]){{ end }};
+export type {{ .Name }} = t.TypeOf<typeof {{ .Name }}>;
 {{ end }}`
@@ -0,0 +1,194 @@
+package languages
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/jguerreno/JSON-Converter/internal/models"
+)
+
+// formatTypeScriptCodecName turns a class name into the prefix of its
+// fromJson/toJson functions.
+func formatTypeScriptCodecName(className string) string {
+	r, size := utf8.DecodeRuneInString(className)
+	return string(unicode.ToLower(r)) + className[size:]
+}
+
+// formatTypeScriptFromJson returns the checked value of a field read from
+// the object called data.
+func formatTypeScriptFromJson(class models.ClassDefinition, field models.FieldDefinition) string {
+	source := fmt.Sprintf("data[%q]", field.JSONTag)
+	path := strconv.Quote(class.Name + "." + field.JSONTag)
+
+	element := func(value string) string {
+		switch {
+		case !isPrimitiveType(field.TypeName):
+			return formatTypeScriptCodecName(field.TypeName) + "FromJson(" + value + ")"
+		case len(field.Enum) > 0 && field.TypeName == "string":
+			return "expectOneOf(" + value + ", [" + quoteEnum(field.Enum, ", ") + "] as const, " + path + ")"
+		case field.TypeName == "string":
+			return "expectString(" + value + ", " + path + ")"
+		case field.TypeName == "bool":
+			return "expectBoolean(" + value + ", " + path + ")"
+		case field.TypeName == "interface{}":
+			return value
+		default:
+			return "expectNumber(" + value + ", " + path + ")"
+		}
+	}
+
+	converted := typeScriptConvert(field, source, element, func(list string) string {
+		return "expectArray(" + list + ", " + path + ")"
+	}, func(record string) string {
+		return "expectObject(" + record + ", " + path + ")"
+	})
+	if field.IsOptional {
+		return source + " == null ? undefined : " + converted
+	}
+	return converted
+}
+
+func formatTypeScriptToJson(field models.FieldDefinition) string {
+	source := "value." + field.JSONTag
+	if !typeScriptIdentifier.MatchString(field.JSONTag) {
+		source = fmt.Sprintf("value[%q]", field.JSONTag)
+	}
+	if isPrimitiveType(field.TypeName) {
+		return source
+	}
+
+	identity := func(value string) string { return value }
+	converted := typeScriptConvert(field, source, func(value string) string {
+		return formatTypeScriptCodecName(field.TypeName) + "ToJson(" + value + ")"
+	}, identity, identity)
+	if field.IsOptional {
+		return source + " === undefined ? undefined : " + converted
+	}
+	return converted
+}
+
+// typeScriptCodecHelpers reports which helper functions the codecs of
+// classes call, so that unused ones are not emitted: they would fail builds
+// with noUnusedLocals.
+func typeScriptCodecHelpers(classes []models.ClassDefinition) map[string]bool {
+	var code strings.Builder
+	for _, class := range classes {
+		for _, field := range class.Fields {
+			code.WriteString(formatTypeScriptFromJson(class, field))
+			code.WriteString(formatTypeScriptToJson(field))
+		}
+	}
+
+	helpers := map[string]bool{
+		"expectObject": len(classes) > 0,
+		"requireKeys":  len(classes) > 0,
+	}
+	for _, name := range []string{"expectArray", "expectString", "expectNumber", "expectBoolean", "expectOneOf", "mapRecord"} {
+		helpers[name] = strings.Contains(code.String(), name+"(")
+	}
+	return helpers
+}
+
+// typeScriptConvert applies element to each value inside source, walking
+// through arrays and records after checking them with list and record.
+func typeScriptConvert(field models.FieldDefinition, source string, element, list, record func(string) string) string {
+	value := element
+	if field.IsMap {
+		value = func(mapping string) string {
+			return "mapRecord(" + record(mapping) + ", (value) => " + element("value") + ")"
+		}
+	}
+	if field.IsList {
+		return list(source) + ".map((item) => " + value("item") + ")"
+	}
+	return value(source)
+}
+
+var typescriptCodecTemplate = `
+{{- define "codec" }}
+{{- range .Classes }}
+{{- $class := . }}
+export function {{ codecName .Name }}FromJson(json: unknown): {{ .Name }} {
+  const data = expectObject(json, "{{ .Name }}");
+  requireKeys(data, [{{ range $index, $key := requiredKeys . }}{{ if $index }}, {{ end }}{{ printf "%q" $key }}{{ end }}], "{{ .Name }}");
+  return {
+{{- range .Fields }}
+    {{ propertyName . }}: {{ fromJson $class . }},
+{{- end }}
+  };
+}
+
+export function {{ codecName .Name }}ToJson(value: {{ .Name }}): Record<string, unknown> {
+  return {
+{{- range .Fields }}
+    {{ propertyName . }}: {{ toJson . }},
+{{- end }}
+  };
+}
+{{ end }}
+{{- if .Helpers.expectObject }}
+function expectObject(value: unknown, path: string): Record<string, unknown> {
+  if (typeof value !== "object" || value === null || Array.isArray(value)) {
+    throw new TypeError(` + "`${path}: expected object`" + `);
+  }
+  return value as Record<string, unknown>;
+}
+{{ end }}
+{{- if .Helpers.expectArray }}
+function expectArray(value: unknown, path: string): unknown[] {
+  if (!Array.isArray(value)) {
+    throw new TypeError(` + "`${path}: expected array`" + `);
+  }
+  return value;
+}
+{{ end }}
+{{- if .Helpers.expectString }}
+function expectString(value: unknown, path: string): string {
+  if (typeof value !== "string") {
+    throw new TypeError(` + "`${path}: expected string`" + `);
+  }
+  return value;
+}
+{{ end }}
+{{- if .Helpers.expectNumber }}
+function expectNumber(value: unknown, path: string): number {
+  if (typeof value !== "number") {
+    throw new TypeError(` + "`${path}: expected number`" + `);
+  }
+  return value;
+}
+{{ end }}
+{{- if .Helpers.expectBoolean }}
+function expectBoolean(value: unknown, path: string): boolean {
+  if (typeof value !== "boolean") {
+    throw new TypeError(` + "`${path}: expected boolean`" + `);
+  }
+  return value;
+}
+{{ end }}
+{{- if .Helpers.expectOneOf }}
+function expectOneOf<T extends string>(value: unknown, allowed: readonly T[], path: string): T {
+  if (!allowed.includes(value as T)) {
+    throw new TypeError(` + "`${path}: expected one of ${allowed.join(\", \")}`" + `);
+  }
+  return value as T;
+}
+{{ end }}
+{{- if .Helpers.requireKeys }}
+function requireKeys(data: Record<string, unknown>, keys: string[], path: string): void {
+  for (const key of keys) {
+    if (!(key in data)) {
+      throw new TypeError(` + "`${path}: missing required field \"${key}\"`" + `);
+    }
+  }
+}
+{{ end }}
+{{- if .Helpers.mapRecord }}
+function mapRecord<T, U>(record: Record<string, T>, convert: (value: T) => U): Record<string, U> {
+  return Object.fromEntries(Object.entries(record).map(([key, value]) => [key, convert(value)]));
+}
+{{ end }}
+{{- end }}`
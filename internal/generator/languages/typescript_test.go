@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/jguerreno/JSON-Converter/internal/models"
+	"github.com/jguerreno/JSON-Converter/internal/parser"
 )
 
 func TestConvertTypescriptType(t *testing.T) {
@@ -104,3 +105,229 @@ func TestGenerateTypescript(t *testing.T) {
 	}
 
 }
+
+var typescriptValidatorClasses = []models.ClassDefinition{
+	{
+		Name: "User",
+		Fields: []models.FieldDefinition{
+			{Name: "Id", JSONTag: "id", TypeName: "string", Format: "uuid"},
+			{Name: "Age", JSONTag: "age", TypeName: "int"},
+			{Name: "Email", JSONTag: "e-mail", TypeName: "string", IsOptional: true},
+			{Name: "Role", JSONTag: "role", TypeName: "string", Enum: []string{"admin", "user"}},
+			{Name: "Tags", JSONTag: "tags", TypeName: "string", IsList: true},
+			{Name: "Labels", JSONTag: "labels", TypeName: "string", IsMap: true},
+			{Name: "City", JSONTag: "city", TypeName: "City"},
+		},
+	},
+	{
+		Name: "City",
+		Fields: []models.FieldDefinition{
+			{Name: "Name", JSONTag: "name", TypeName: "string"},
+		},
+	},
+}
+
+func TestGenerateTypescriptZod(t *testing.T) {
+	code, err := NewTypeScriptGenerator().GenerateWithOptions(typescriptValidatorClasses, models.Options{"style": "zod"})
+	if err != nil {
+		t.Fatalf("GenerateTypescript failed: %v", err)
+	}
+
+	expectedCode := []string{
+		`import { z } from "zod";`,
+		`export const UserSchema = z.object({`,
+		`id: z.string().uuid(),`,
+		`age: z.number().int(),`,
+		`"e-mail": z.string().nullish(),`,
+		`role: z.enum(["admin", "user"]),`,
+		`tags: z.array(z.string()),`,
+		`labels: z.record(z.string(), z.string()),`,
+		`city: CitySchema,`,
+		`export type User = z.infer<typeof UserSchema>;`,
+	}
+
+	for _, expected := range expectedCode {
+		if !strings.Contains(code, expected) {
+			t.Errorf("Generated Zod code missing: %q", expected)
+		}
+	}
+	if strings.Index(code, "CitySchema =") > strings.Index(code, "UserSchema =") {
+		t.Error("schemas must be declared before the schemas that use them")
+	}
+}
+
+func TestGenerateTypescriptIOTS(t *testing.T) {
+	code, err := NewTypeScriptGenerator().GenerateWithOptions(typescriptValidatorClasses, models.Options{"style": "io-ts"})
+	if err != nil {
+		t.Fatalf("GenerateTypescript failed: %v", err)
+	}
+
+	expectedCode := []string{
+		`import * as t from "io-ts";`,
+		`export const City = t.type({`,
+		`export const User = t.intersection([`,
+		`    id: t.string,`,
+		`    role: t.keyof({ "admin": null, "user": null }),`,
+		`    labels: t.record(t.string, t.string),`,
+		`  t.partial({
+    "e-mail": t.union([t.string, t.null]),
+  }),`,
+		`export type User = t.TypeOf<typeof User>;`,
+	}
+
+	for _, expected := range expectedCode {
+		if !strings.Contains(code, expected) {
+			t.Errorf("Generated io-ts code missing: %q", expected)
+		}
+	}
+}
+
+func TestGenerateTypescriptRecursiveZod(t *testing.T) {
+	classes := []models.ClassDefinition{{
+		Name: "Node",
+		Fields: []models.FieldDefinition{
+			{Name: "Children", JSONTag: "children", TypeName: "Node", IsList: true},
+		},
+	}}
+
+	code, err := NewTypeScriptGenerator().GenerateWithOptions(classes, models.Options{"style": "zod"})
+	if err != nil {
+		t.Fatalf("GenerateTypescript failed: %v", err)
+	}
+	if !strings.Contains(code, "children: z.array(z.lazy(() => NodeSchema)),") {
+		t.Errorf("self references should be lazy, got:\n%s", code)
+	}
+	// TypeScript cannot infer a type for a schema that refers to itself.
+	for _, expected := range []string{
+		"export interface Node {\n  children: Node[];\n}",
+		"export const NodeSchema: z.ZodType<Node> = z.object({",
+	} {
+		if !strings.Contains(code, expected) {
+			t.Errorf("recursive schemas need an explicit type, missing %q:\n%s", expected, code)
+		}
+	}
+	if strings.Contains(code, "z.infer<typeof NodeSchema>") {
+		t.Errorf("recursive schemas should not infer their type, got:\n%s", code)
+	}
+}
+
+func TestGenerateTypescriptValidatorsAcceptNull(t *testing.T) {
+	classes, err := parser.ParseJSON([]byte(`[{"a": 1}, {"a": null}]`), "Root")
+	if err != nil {
+		t.Fatalf("ParseJSON failed: %v", err)
+	}
+
+	tests := []struct {
+		style    string
+		expected string
+	}{
+		{"zod", "a: z.number().int().nullish(),"},
+		{"io-ts", "t.partial({\n  a: t.union([t.number, t.null]),\n})"},
+	}
+	for _, tt := range tests {
+		code, err := NewTypeScriptGenerator().GenerateWithOptions(classes, models.Options{"style": tt.style})
+		if err != nil {
+			t.Fatalf("GenerateTypescript(%s) failed: %v", tt.style, err)
+		}
+		if !strings.Contains(code, tt.expected) {
+			t.Errorf("%s validator should accept the null sample, missing %q:\n%s", tt.style, tt.expected, code)
+		}
+	}
+}
+
+func TestGenerateTypescriptUnknownStyle(t *testing.T) {
+	if _, err := NewTypeScriptGenerator().GenerateWithOptions(typescriptValidatorClasses, models.Options{"style": "yup"}); err == nil {
+		t.Error("expected error for unsupported style")
+	}
+}
+
+func TestGenerateTypescriptCodec(t *testing.T) {
+	code, err := NewTypeScriptGenerator().GenerateWithOptions(typescriptValidatorClasses, models.Options{"codec": "true"})
+	if err != nil {
+		t.Fatalf("GenerateTypescript failed: %v", err)
+	}
+
+	expectedCode := []string{
+		`export function userFromJson(json: unknown): User {`,
+		`  const data = expectObject(json, "User");`,
+		`  requireKeys(data, ["id", "age", "role", "tags", "labels", "city"], "User");`,
+		`    "e-mail": data["e-mail"] == null ? undefined : expectString(data["e-mail"], "User.e-mail"),`,
+		`    role: expectOneOf(data["role"], ["admin", "user"] as const, "User.role"),`,
+		`    tags: expectArray(data["tags"], "User.tags").map((item) => expectString(item, "User.tags")),`,
+		`    labels: mapRecord(expectObject(data["labels"], "User.labels"), (value) => expectString(value, "User.labels")),`,
+		`    city: cityFromJson(data["city"]),`,
+		`export function userToJson(value: User): Record<string, unknown> {`,
+		`    "e-mail": value["e-mail"],`,
+		`    city: cityToJson(value.city),`,
+		`function requireKeys(data: Record<string, unknown>, keys: string[], path: string): void {`,
+	}
+
+	for _, expected := range expectedCode {
+		if !strings.Contains(code, expected) {
+			t.Errorf("Generated Typescript code missing: %q", expected)
+		}
+	}
+}
+
+func TestGenerateTypescriptCodecOnlyUsedHelpers(t *testing.T) {
+	classes := []models.ClassDefinition{{
+		Name:   "Point",
+		Fields: []models.FieldDefinition{{Name: "X", JSONTag: "x", TypeName: "float64"}},
+	}}
+
+	code, err := NewTypeScriptGenerator().GenerateWithOptions(classes, models.Options{"codec": "true"})
+	if err != nil {
+		t.Fatalf("GenerateTypescript failed: %v", err)
+	}
+	for _, used := range []string{"function expectObject(", "function requireKeys(", "function expectNumber("} {
+		if !strings.Contains(code, used) {
+			t.Errorf("Generated Typescript code missing: %q", used)
+		}
+	}
+	// Unused functions fail builds with noUnusedLocals.
+	for _, unused := range []string{"expectString", "expectBoolean", "expectArray", "expectOneOf", "mapRecord"} {
+		if strings.Contains(code, unused) {
+			t.Errorf("Generated Typescript code should not define unused %s:\n%s", unused, code)
+		}
+	}
+}
+
+func TestGenerateTypescriptDocs(t *testing.T) {
+	tests := []struct {
+		style        string
+		expectedCode []string
+	}{
+		{
+			style: "interface",
+			expectedCode: []string{
+				"/** Path: $.users[*] */\nexport interface UsersItem {",
+				"  /** Example: \"alice@example.com\" (path: $.users[*].email) */\n  email: string;",
+			},
+		},
+		{
+			style: "zod",
+			expectedCode: []string{
+				"/** Path: $.users[*] */\nexport const UsersItemSchema",
+				"  /** Examples: 30, 41 (path: $.users[*].age) */\n  age: z.number().int().nullish(),",
+			},
+		},
+		{
+			style: "io-ts",
+			expectedCode: []string{
+				"    /** Example: \"alice@example.com\" (path: $.users[*].email) */\n    email: t.string,",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		code, err := NewTypeScriptGenerator().GenerateWithOptions(documentedClasses, models.Options{"style": tt.style, "docs": "true"})
+		if err != nil {
+			t.Fatalf("GenerateTypescript(%s) failed: %v", tt.style, err)
+		}
+		for _, expected := range tt.expectedCode {
+			if !strings.Contains(code, expected) {
+				t.Errorf("Generated Typescript %s code missing: %q\n%s", tt.style, expected, code)
+			}
+		}
+	}
+}
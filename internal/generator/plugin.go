@@ -0,0 +1,248 @@
+package generator
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/jguerreno/JSON-Converter/internal/models"
+)
+
+// PluginPrefix is the file name prefix of generator plugins: a plugin for
+// language "terraform" is an executable named json-converter-gen-terraform.
+const PluginPrefix = "json-converter-gen-"
+
+// describeTimeout bounds the --describe handshake, so that a plugin which
+// hangs cannot stall discovery.
+const describeTimeout = 5 * time.Second
+
+// PluginRequest is written as JSON to a plugin's stdin. Classes use the
+// json tags of models.ClassDefinition.
+type PluginRequest struct {
+	Language string                   `json:"language"`
+	Classes  []models.ClassDefinition `json:"classes"`
+	Options  models.Options           `json:"options"`
+}
+
+// PluginResponse is read as JSON from a plugin's stdout. A plugin reports
+// problems with the input through Error rather than a malformed response.
+type PluginResponse struct {
+	Files []GeneratedFile `json:"files"`
+	Error string          `json:"error,omitempty"`
+}
+
+// PluginDescription is what a plugin prints when run with --describe.
+type PluginDescription struct {
+	Name      string `json:"name"`
+	Extension string `json:"extension"`
+}
+
+// PluginGenerator runs an external executable for every generation, in the
+// spirit of protoc plugins.
+type PluginGenerator struct {
+	name      string
+	extension string
+	path      string
+}
+
+// NewPluginGenerator describes the plugin at path with the --describe
+// handshake. The language name defaults to the part of the file name after
+// PluginPrefix.
+func NewPluginGenerator(path string) (*PluginGenerator, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), describeTimeout)
+	defer cancel()
+
+	output, err := runPlugin(ctx, path, nil, "--describe")
+	if err != nil {
+		return nil, err
+	}
+
+	var description PluginDescription
+	if err := json.Unmarshal(output, &description); err != nil {
+		return nil, fmt.Errorf("plugin %s: invalid --describe output: %w", path, err)
+	}
+	if description.Name == "" {
+		description.Name = pluginLanguage(filepath.Base(path))
+	}
+	if description.Extension == "" {
+		return nil, fmt.Errorf("plugin %s: --describe output has no extension", path)
+	}
+
+	return &PluginGenerator{
+		name:      description.Name,
+		extension: description.Extension,
+		path:      path,
+	}, nil
+}
+
+func (p *PluginGenerator) GetName() string {
+	return p.name
+}
+
+func (p *PluginGenerator) GetFileExtension() string {
+	return p.extension
+}
+
+func (p *PluginGenerator) Generate(classes []models.ClassDefinition) (string, error) {
+	return p.GenerateWithOptions(classes, nil)
+}
+
+// GenerateWithOptions returns the contents of every generated file, one
+// after the other. Use GenerateFiles to keep them apart.
+func (p *PluginGenerator) GenerateWithOptions(classes []models.ClassDefinition, opts models.Options) (string, error) {
+	files, err := p.GenerateFiles(classes, opts)
+	if err != nil {
+		return "", err
+	}
+
+	var buf strings.Builder
+	for _, file := range files {
+		buf.WriteString(file.Content)
+	}
+	return buf.String(), nil
+}
+
+func (p *PluginGenerator) GenerateFiles(classes []models.ClassDefinition, opts models.Options) ([]GeneratedFile, error) {
+	return p.GenerateFilesContext(context.Background(), classes, opts)
+}
+
+// GenerateFilesContext runs the plugin, killing it once ctx is done.
+func (p *PluginGenerator) GenerateFilesContext(ctx context.Context, classes []models.ClassDefinition, opts models.Options) ([]GeneratedFile, error) {
+	if opts == nil {
+		opts = models.Options{}
+	}
+	request, err := json.Marshal(PluginRequest{Language: p.name, Classes: classes, Options: opts})
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := runPlugin(ctx, p.path, request)
+	if err != nil {
+		return nil, err
+	}
+
+	var response PluginResponse
+	if err := json.Unmarshal(output, &response); err != nil {
+		return nil, fmt.Errorf("plugin %s: invalid response: %w", p.name, err)
+	}
+	if response.Error != "" {
+		return nil, fmt.Errorf("plugin %s: %s", p.name, response.Error)
+	}
+	if len(response.Files) == 0 {
+		return nil, fmt.Errorf("plugin %s: no files generated", p.name)
+	}
+	for _, file := range response.Files {
+		if !filepath.IsLocal(file.Name) {
+			return nil, fmt.Errorf("plugin %s: invalid file name %q", p.name, file.Name)
+		}
+	}
+	return response.Files, nil
+}
+
+func runPlugin(ctx context.Context, path string, stdin []byte, args ...string) ([]byte, error) {
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, path, args...)
+	cmd.Stdin = bytes.NewReader(stdin)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		if ctx.Err() != nil {
+			return nil, fmt.Errorf("plugin %s failed: %w", filepath.Base(path), ctx.Err())
+		}
+		if message := strings.TrimSpace(stderr.String()); message != "" {
+			return nil, fmt.Errorf("plugin %s failed: %w: %s", filepath.Base(path), err, message)
+		}
+		return nil, fmt.Errorf("plugin %s failed: %w", filepath.Base(path), err)
+	}
+	return stdout.Bytes(), nil
+}
+
+// DiscoverPlugins registers the plugins found in dirs and then on PATH. A
+// plugin never replaces a language that is already registered, so earlier
+// directories take precedence and built-in languages cannot be shadowed.
+//
+// A plugin that fails the --describe handshake is skipped rather than
+// failing discovery; SkippedPlugins reports it, and GetLanguage explains why
+// its language is missing.
+func (r *GeneratorRegistry) DiscoverPlugins(dirs ...string) error {
+	searchPath := append(append([]string{}, dirs...), filepath.SplitList(os.Getenv("PATH"))...)
+	for _, dir := range searchPath {
+		if dir == "" {
+			continue
+		}
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			continue
+		}
+		for _, entry := range entries {
+			if !strings.HasPrefix(entry.Name(), PluginPrefix) || !isExecutable(entry) {
+				continue
+			}
+			if _, err := r.GetLanguage(pluginLanguage(entry.Name())); err == nil {
+				continue
+			}
+
+			gen, err := NewPluginGenerator(filepath.Join(dir, entry.Name()))
+			if err != nil {
+				r.skipPlugin(pluginLanguage(entry.Name()), err)
+				continue
+			}
+			if _, err := r.GetLanguage(gen.GetName()); err == nil {
+				continue
+			}
+			r.Register(gen)
+		}
+	}
+	return nil
+}
+
+func (r *GeneratorRegistry) skipPlugin(language string, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.skipped[language]; !ok {
+		r.skipped[language] = err
+	}
+}
+
+// SkippedPlugins returns the errors of the plugins that DiscoverPlugins
+// skipped, ordered by language.
+func (r *GeneratorRegistry) SkippedPlugins() []error {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	languages := make([]string, 0, len(r.skipped))
+	for language := range r.skipped {
+		languages = append(languages, language)
+	}
+	sort.Strings(languages)
+
+	errs := make([]error, 0, len(languages))
+	for _, language := range languages {
+		errs = append(errs, r.skipped[language])
+	}
+	return errs
+}
+
+func pluginLanguage(fileName string) string {
+	return strings.TrimSuffix(strings.TrimPrefix(fileName, PluginPrefix), ".exe")
+}
+
+func isExecutable(entry os.DirEntry) bool {
+	info, err := entry.Info()
+	if err != nil || info.IsDir() {
+		return false
+	}
+	if runtime.GOOS == "windows" {
+		return strings.EqualFold(filepath.Ext(entry.Name()), ".exe")
+	}
+	return info.Mode()&0111 != 0
+}
@@ -0,0 +1,204 @@
+package generator
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jguerreno/JSON-Converter/internal/models"
+)
+
+// The test binary doubles as a plugin when this variable is set, so the
+// plugin protocol is exercised without building a separate executable.
+const testPluginEnv = "JSON_CONVERTER_TEST_PLUGIN"
+
+func TestMain(m *testing.M) {
+	if os.Getenv(testPluginEnv) != "" {
+		os.Exit(runTestPlugin())
+	}
+	os.Exit(m.Run())
+}
+
+func runTestPlugin() int {
+	if len(os.Args) > 1 && os.Args[1] == "--describe" {
+		if os.Getenv(testPluginEnv) == "broken" {
+			fmt.Fprintln(os.Stderr, "missing runtime")
+			return 1
+		}
+		fmt.Print(`{"extension": "tf"}`)
+		return 0
+	}
+
+	var request PluginRequest
+	data, _ := io.ReadAll(os.Stdin)
+	if err := json.Unmarshal(data, &request); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+
+	switch request.Options.Get("mode", "") {
+	case "fail":
+		fmt.Fprintln(os.Stderr, "unsupported input shape")
+		return 2
+	case "hang":
+		time.Sleep(time.Minute)
+	case "reject":
+		fmt.Print(`{"error": "no classes to describe"}`)
+		return 0
+	}
+
+	files := []GeneratedFile{}
+	for _, class := range request.Classes {
+		files = append(files, GeneratedFile{
+			Name:    strings.ToLower(class.Name) + ".tf",
+			Content: fmt.Sprintf("variable %q {}\n", class.Name),
+		})
+	}
+	json.NewEncoder(os.Stdout).Encode(PluginResponse{Files: files})
+	return 0
+}
+
+// installTestPlugin copies the test binary into a fresh plugins directory
+// under the plugin name for language.
+func installTestPlugin(t *testing.T, language string) string {
+	t.Helper()
+	t.Setenv(testPluginEnv, "1")
+	t.Setenv("PATH", "")
+
+	executable, err := os.Executable()
+	if err != nil {
+		t.Fatal(err)
+	}
+	binary, err := os.ReadFile(executable)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dir := t.TempDir()
+	name := PluginPrefix + language
+	if runtime.GOOS == "windows" {
+		name += ".exe"
+	}
+	if err := os.WriteFile(filepath.Join(dir, name), binary, 0755); err != nil {
+		t.Fatal(err)
+	}
+	return dir
+}
+
+func TestGeneratorRegistry_DiscoverPlugins(t *testing.T) {
+	dir := installTestPlugin(t, "terraform")
+
+	registry := NewGeneratorRegistry()
+	if err := registry.DiscoverPlugins(dir); err != nil {
+		t.Fatalf("DiscoverPlugins() error = %v", err)
+	}
+	service := NewGeneratorServiceWithRegistry(registry)
+
+	if extension, err := service.GetFileExtension("terraform"); err != nil || extension != "tf" {
+		t.Errorf("GetFileExtension() = %q, %v, want tf", extension, err)
+	}
+
+	classes := []models.ClassDefinition{{Name: "Server"}, {Name: "Network"}}
+	files, err := service.GenerateFiles("terraform", classes, nil)
+	if err != nil {
+		t.Fatalf("GenerateFiles() error = %v", err)
+	}
+	if len(files) != 2 || files[0].Name != "server.tf" || files[1].Content != "variable \"Network\" {}\n" {
+		t.Errorf("GenerateFiles() = %v", files)
+	}
+
+	output, err := service.Generate("terraform", classes[:1])
+	if err != nil || output != "variable \"Server\" {}\n" {
+		t.Errorf("Generate() = %q, %v", output, err)
+	}
+}
+
+func TestGeneratorRegistry_PluginErrors(t *testing.T) {
+	dir := installTestPlugin(t, "terraform")
+
+	registry := NewGeneratorRegistry()
+	if err := registry.DiscoverPlugins(dir); err != nil {
+		t.Fatalf("DiscoverPlugins() error = %v", err)
+	}
+	service := NewGeneratorServiceWithRegistry(registry)
+
+	tests := []struct {
+		mode    string
+		wantErr string
+	}{
+		{"fail", "unsupported input shape"},
+		{"reject", "plugin terraform: no classes to describe"},
+	}
+
+	for _, tt := range tests {
+		_, err := service.GenerateWithOptions("terraform", nil, models.Options{"mode": tt.mode})
+		if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+			t.Errorf("GenerateWithOptions(mode=%s) error = %v, want %q", tt.mode, err, tt.wantErr)
+		}
+	}
+}
+
+func TestGeneratorRegistry_PluginCancelled(t *testing.T) {
+	dir := installTestPlugin(t, "terraform")
+
+	registry := NewGeneratorRegistry()
+	if err := registry.DiscoverPlugins(dir); err != nil {
+		t.Fatalf("DiscoverPlugins() error = %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	_, err := registry.GenerateFilesContext(ctx, "terraform", nil, models.Options{"mode": "hang"})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("GenerateFilesContext() error = %v, want context.DeadlineExceeded", err)
+	}
+	if elapsed := time.Since(start); elapsed > 10*time.Second {
+		t.Errorf("GenerateFilesContext() returned after %s, want the plugin killed", elapsed)
+	}
+}
+
+func TestGeneratorRegistry_PluginsDoNotShadowBuiltins(t *testing.T) {
+	dir := installTestPlugin(t, "go")
+
+	registry := NewGeneratorRegistry()
+	if err := registry.DiscoverPlugins(dir); err != nil {
+		t.Fatalf("DiscoverPlugins() error = %v", err)
+	}
+
+	gen, err := registry.GetLanguage("go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, isPlugin := gen.(*PluginGenerator); isPlugin {
+		t.Error("a plugin named go replaced the built-in generator")
+	}
+}
+
+func TestGeneratorRegistry_SkipsBrokenPlugins(t *testing.T) {
+	dir := installTestPlugin(t, "terraform")
+	t.Setenv(testPluginEnv, "broken")
+
+	registry := NewGeneratorRegistry()
+	if err := registry.DiscoverPlugins(dir); err != nil {
+		t.Fatalf("DiscoverPlugins() error = %v", err)
+	}
+
+	if skipped := registry.SkippedPlugins(); len(skipped) != 1 || !strings.Contains(skipped[0].Error(), "missing runtime") {
+		t.Errorf("SkippedPlugins() = %v, want the terraform plugin error", skipped)
+	}
+	if _, err := registry.GetLanguage("terraform"); err == nil || !strings.Contains(err.Error(), "missing runtime") {
+		t.Errorf("GetLanguage() error = %v, want the reason the plugin was skipped", err)
+	}
+	if _, err := registry.GetLanguage("go"); err != nil {
+		t.Errorf("GetLanguage(go) error = %v", err)
+	}
+}
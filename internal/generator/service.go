@@ -10,6 +10,8 @@ import (
 type GeneratorService interface {
 	GenerateFromJSON(jsonData []byte, rootName, language string) (string, error)
 	Generate(language string, classes []models.ClassDefinition) (string, error)
+	GenerateWithOptions(language string, classes []models.ClassDefinition, opts models.Options) (string, error)
+	GenerateFiles(language string, classes []models.ClassDefinition, opts models.Options) ([]GeneratedFile, error)
 	GetSupportedLanguages() []string
 	GetFileExtension(language string) (string, error)
 }
@@ -24,6 +26,14 @@ func NewGeneratorService() GeneratorService {
 	}
 }
 
+// NewGeneratorServiceWithRegistry returns a service backed by registry, such
+// as one extended with user templates.
+func NewGeneratorServiceWithRegistry(registry *GeneratorRegistry) GeneratorService {
+	return &generatorService{
+		registry: registry,
+	}
+}
+
 func (s *generatorService) GenerateFromJSON(jsonData []byte, rootName, language string) (string, error) {
 	classes, err := parser.ParseJSON(jsonData, rootName)
 	if err != nil {
@@ -36,6 +46,14 @@ func (s *generatorService) Generate(language string, classes []models.ClassDefin
 	return s.registry.Generate(language, classes)
 }
 
+func (s *generatorService) GenerateWithOptions(language string, classes []models.ClassDefinition, opts models.Options) (string, error) {
+	return s.registry.GenerateWithOptions(language, classes, opts)
+}
+
+func (s *generatorService) GenerateFiles(language string, classes []models.ClassDefinition, opts models.Options) ([]GeneratedFile, error) {
+	return s.registry.GenerateFiles(language, classes, opts)
+}
+
 func (s *generatorService) GetSupportedLanguages() []string {
 	return s.registry.GetSupportedLanguages()
 }
@@ -28,6 +28,12 @@ func TestGeneratorService_Generate(t *testing.T) {
 		{"Python generation", "python", false},
 		{"TypeScript generation", "typescript", false},
 		{"Java generation", "java", false},
+		{"Proto generation", "proto", false},
+		{"GraphQL generation", "graphql", false},
+		{"SQL generation", "sql", false},
+		{"Avro generation", "avro", false},
+		{"Parquet generation", "parquet", false},
+		{"JSON Schema generation", "jsonschema", false},
 		{"Unsupported language", "rust", true},
 	}
 
@@ -57,6 +63,12 @@ func TestGeneratorService_GetSupportedLanguages(t *testing.T) {
 		"python":     true,
 		"typescript": true,
 		"java":       true,
+		"proto":      true,
+		"graphql":    true,
+		"sql":        true,
+		"avro":       true,
+		"parquet":    true,
+		"jsonschema": true,
 	}
 
 	for _, lang := range languages {
@@ -83,6 +95,12 @@ func TestGeneratorService_GetFileExtension(t *testing.T) {
 		{"Python extension", "python", "py", false},
 		{"TypeScript extension", "typescript", "ts", false},
 		{"Java extension", "java", "java", false},
+		{"Proto extension", "proto", "proto", false},
+		{"GraphQL extension", "graphql", "graphql", false},
+		{"SQL extension", "sql", "sql", false},
+		{"Avro extension", "avro", "avsc", false},
+		{"Parquet extension", "parquet", "schema", false},
+		{"JSON Schema extension", "jsonschema", "schema.json", false},
 		{"Unknown language", "rust", "txt", true},
 	}
 
@@ -0,0 +1,107 @@
+package generator
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/jguerreno/JSON-Converter/internal/generator/languages"
+)
+
+// TemplateFuncProvider is implemented by generators whose helper funcs
+// remain available to a user template that overrides them.
+type TemplateFuncProvider interface {
+	TemplateFuncs() template.FuncMap
+}
+
+const templateExtension = ".tmpl"
+
+// LoadTemplates registers the user templates found at path, which is either
+// a single <language>.tmpl file or a directory of them. A template named
+// after a registered language replaces it and keeps its helper funcs and
+// file extension; any other name defines a new language and needs a
+// <language>.json type mapping next to it (see languages.TemplateConfig).
+func (r *GeneratorRegistry) LoadTemplates(path string) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+
+	files := []string{path}
+	if info.IsDir() {
+		files, err = filepath.Glob(filepath.Join(path, "*"+templateExtension))
+		if err != nil {
+			return err
+		}
+		if len(files) == 0 {
+			return fmt.Errorf("no %s files found in %s", templateExtension, path)
+		}
+		sort.Strings(files)
+	}
+
+	for _, file := range files {
+		if err := r.loadTemplate(file); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *GeneratorRegistry) loadTemplate(file string) error {
+	text, err := os.ReadFile(file)
+	if err != nil {
+		return err
+	}
+
+	base := strings.TrimSuffix(file, filepath.Ext(file))
+	config, hasConfig, err := readTemplateConfig(base + ".json")
+	if err != nil {
+		return err
+	}
+	if config.Name == "" {
+		config.Name = filepath.Base(base)
+	}
+
+	var funcs template.FuncMap
+	if builtin, err := r.GetLanguage(config.Name); err == nil {
+		if config.Extension == "" {
+			config.Extension = builtin.GetFileExtension()
+		}
+		if provider, ok := builtin.(TemplateFuncProvider); ok {
+			funcs = provider.TemplateFuncs()
+		}
+	} else if !hasConfig {
+		return fmt.Errorf("template %s defines language '%s', which needs a type mapping in %s.json", file, config.Name, base)
+	}
+	if config.Extension == "" {
+		return fmt.Errorf("type mapping for language '%s' has no extension", config.Name)
+	}
+
+	gen, err := languages.NewTemplateGenerator(config, string(text), funcs)
+	if err != nil {
+		return err
+	}
+	r.Register(gen)
+	return nil
+}
+
+func readTemplateConfig(file string) (languages.TemplateConfig, bool, error) {
+	var config languages.TemplateConfig
+	data, err := os.ReadFile(file)
+	if errors.Is(err, fs.ErrNotExist) {
+		return config, false, nil
+	}
+	if err != nil {
+		return config, false, err
+	}
+	if err := json.Unmarshal(data, &config); err != nil {
+		return config, false, fmt.Errorf("invalid type mapping %s: %w", file, err)
+	}
+	return config, true, nil
+}
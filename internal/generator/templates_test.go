@@ -0,0 +1,121 @@
+package generator
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/jguerreno/JSON-Converter/internal/models"
+)
+
+var templateClasses = []models.ClassDefinition{
+	{
+		Name: "User",
+		Fields: []models.FieldDefinition{
+			{Name: "Name", JSONTag: "name", TypeName: "string"},
+			{Name: "Tags", JSONTag: "tags", TypeName: "string", IsList: true},
+			{Name: "Age", JSONTag: "age", TypeName: "int", IsOptional: true},
+		},
+	},
+}
+
+func writeTemplateFiles(t *testing.T, files map[string]string) string {
+	t.Helper()
+	dir := t.TempDir()
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+func TestGeneratorRegistry_LoadTemplates(t *testing.T) {
+	dir := writeTemplateFiles(t, map[string]string{
+		"kotlin.tmpl": `{{ range .Classes }}data class {{ .Name }}({{ range .Fields }}val {{ camelCase .Name }}: {{ formatType . }}, {{ end }}){{ end }}`,
+		"kotlin.json": `{"extension": "kt", "types": {"string": "String", "int": "Int"}, "list": "List<%s>", "optional": "%s?"}`,
+		"go.tmpl":     `{{ range .Classes }}type {{ .Name }} struct { {{ range .Fields }}{{ .Name }} {{ formatType . }} "{{ formatJsonTag . }}"; {{ end }}}{{ end }}`,
+	})
+
+	registry := NewGeneratorRegistry()
+	if err := registry.LoadTemplates(dir); err != nil {
+		t.Fatalf("LoadTemplates() error = %v", err)
+	}
+	service := NewGeneratorServiceWithRegistry(registry)
+
+	tests := []struct {
+		language  string
+		extension string
+		want      string
+	}{
+		{"kotlin", "kt", "data class User(val name: String, val tags: List<String>, val age: Int?, )"},
+		{"go", "go", `type User struct { Name string "name"; Tags []string "tags"; Age *int "age,omitempty"; }`},
+	}
+
+	for _, tt := range tests {
+		output, err := service.Generate(tt.language, templateClasses)
+		if err != nil {
+			t.Fatalf("Generate(%s) error = %v", tt.language, err)
+		}
+		if output != tt.want {
+			t.Errorf("Generate(%s) = %q, want %q", tt.language, output, tt.want)
+		}
+		if extension, _ := service.GetFileExtension(tt.language); extension != tt.extension {
+			t.Errorf("GetFileExtension(%s) = %q, want %q", tt.language, extension, tt.extension)
+		}
+	}
+}
+
+func TestGeneratorRegistry_TemplateTypeHelpers(t *testing.T) {
+	// Every language can be overridden by a template calling convertType
+	// with a type name and formatType with a field.
+	text := `{{ range .Classes }}{{ range .Fields }}{{ convertType .TypeName }}|{{ formatType . }};{{ end }}{{ end }}`
+	want := map[string]string{
+		"go":         "int|*int;",
+		"python":     "int|Optional[int];",
+		"typescript": "number|number;",
+	}
+
+	for _, language := range NewGeneratorRegistry().GetSupportedLanguages() {
+		t.Run(language, func(t *testing.T) {
+			dir := writeTemplateFiles(t, map[string]string{language + ".tmpl": text})
+			registry := NewGeneratorRegistry()
+			if err := registry.LoadTemplates(dir); err != nil {
+				t.Fatalf("LoadTemplates() error = %v", err)
+			}
+
+			output, err := registry.Generate(language, templateClasses)
+			if err != nil {
+				t.Fatalf("Generate() error = %v", err)
+			}
+			if !strings.HasSuffix(output, want[language]) || strings.Count(output, ";") != len(templateClasses[0].Fields) {
+				t.Errorf("Generate() = %q, want one type pair per field ending in %q", output, want[language])
+			}
+		})
+	}
+}
+
+func TestGeneratorRegistry_LoadTemplatesErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		files   map[string]string
+		path    string
+		wantErr string
+	}{
+		{"missing type mapping", map[string]string{"rust.tmpl": "{{ range .Classes }}{{ end }}"}, "rust.tmpl", "needs a type mapping"},
+		{"missing extension", map[string]string{"rust.tmpl": "", "rust.json": `{"types": {}}`}, "", "has no extension"},
+		{"invalid template", map[string]string{"go.tmpl": "{{ range }}"}, "go.tmpl", "failed to parse template for go"},
+		{"empty directory", map[string]string{"README": ""}, "", "no .tmpl files found"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := writeTemplateFiles(t, tt.files)
+			err := NewGeneratorRegistry().LoadTemplates(filepath.Join(dir, tt.path))
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("LoadTemplates() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
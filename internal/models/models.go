@@ -1,19 +1,50 @@
 package models
 
+// XML node kinds a field maps to when the model was inferred from XML.
+const (
+	XMLElement   = "element"
+	XMLAttribute = "attr"
+	XMLCharData  = "chardata"
+)
+
 type ClassDefinition struct {
-	Name   string
-	Fields []FieldDefinition
+	Name    string            `json:"name"`
+	Fields  []FieldDefinition `json:"fields"`
+	XMLName string            `json:"xmlName,omitempty"`
+	Path    string            `json:"path,omitempty"`
 }
 
 type FieldDefinition struct {
-	Name       string
-	JSONTag    string
-	TypeName   string
-	IsList     bool
-	IsOptional bool
+	Name        string       `json:"name"`
+	JSONTag     string       `json:"jsonTag"`
+	TypeName    string       `json:"typeName"`
+	IsList      bool         `json:"isList,omitempty"`
+	IsMap       bool         `json:"isMap,omitempty"`
+	IsOptional  bool         `json:"isOptional,omitempty"`
+	Format      string       `json:"format,omitempty"`
+	Enum        []string     `json:"enum,omitempty"`
+	XMLKind     string       `json:"xmlKind,omitempty"`
+	Constraints *Constraints `json:"constraints,omitempty"`
+	Examples    []string     `json:"examples,omitempty"`
+	Path        string       `json:"path,omitempty"`
+}
+
+// Constraints are value bounds for a field, either observed across the
+// samples it was inferred from or declared by a schema. Observed ranges
+// are only kept for fields with enough distinct samples; with fewer, only
+// a minimum length or size of one marks a field that was never empty. Nil
+// bounds are unknown.
+type Constraints struct {
+	MinLength *int     `json:"minLength,omitempty"`
+	MaxLength *int     `json:"maxLength,omitempty"`
+	Minimum   *float64 `json:"minimum,omitempty"`
+	Maximum   *float64 `json:"maximum,omitempty"`
+	MinItems  *int     `json:"minItems,omitempty"`
+	MaxItems  *int     `json:"maxItems,omitempty"`
 }
 
 type FieldInfo struct {
 	Value      interface{}
 	IsOptional bool
+	Samples    []interface{}
 }
@@ -0,0 +1,32 @@
+package models
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Options holds generator-specific settings such as "tags=yaml,toml".
+// Generators ignore keys they do not understand.
+type Options map[string]string
+
+func (o Options) Get(key, fallback string) string {
+	if value, ok := o[key]; ok && value != "" {
+		return value
+	}
+	return fallback
+}
+
+func (o Options) Bool(key string) bool {
+	value, err := strconv.ParseBool(o[key])
+	return err == nil && value
+}
+
+func (o Options) List(key string) []string {
+	items := []string{}
+	for _, item := range strings.Split(o[key], ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
@@ -0,0 +1,122 @@
+package parser
+
+import (
+	"unicode/utf8"
+
+	"github.com/jguerreno/JSON-Converter/internal/models"
+)
+
+// minRangeSamples is the number of distinct values a field needs before
+// the range they span is kept as bounds. Fewer samples, and certainly a
+// single one, say little about the values the field can take.
+const minRangeSamples = 5
+
+// inferConstraints collects the bounds seen across the samples of a field:
+// string lengths, numeric ranges and list sizes. Strings and lists that
+// were never empty get a minimum length or size of one; the full observed
+// range is only kept once minRangeSamples distinct values were seen. It
+// returns nil when the samples say nothing useful, such as for objects or
+// mixed values.
+func inferConstraints(typeName string, isList bool, samples []interface{}) *models.Constraints {
+	constraints := &models.Constraints{}
+	observed := false
+
+	if isList {
+		var minItems, maxItems *int
+		sizes := map[int]bool{}
+		for _, sample := range samples {
+			if list, ok := sample.([]interface{}); ok {
+				minItems = minInt(minItems, len(list))
+				maxItems = maxInt(maxItems, len(list))
+				sizes[len(list)] = true
+			}
+		}
+		switch {
+		case len(sizes) >= minRangeSamples:
+			constraints.MinItems, constraints.MaxItems = minItems, maxItems
+			observed = true
+		case minItems != nil && *minItems > 0:
+			constraints.MinItems = nonEmpty()
+			observed = true
+		}
+		if !observed {
+			return nil
+		}
+		return constraints
+	}
+
+	var minLength, maxLength *int
+	var minimum, maximum *float64
+	texts := map[string]bool{}
+	numbers := map[float64]bool{}
+	for _, sample := range samples {
+		switch v := sample.(type) {
+		case string:
+			if typeName != "string" {
+				continue
+			}
+			length := utf8.RuneCountInString(v)
+			minLength = minInt(minLength, length)
+			maxLength = maxInt(maxLength, length)
+			texts[v] = true
+		case float64:
+			if typeName != "int" && typeName != "int64" && typeName != "float64" {
+				continue
+			}
+			minimum = minFloat(minimum, v)
+			maximum = maxFloat(maximum, v)
+			numbers[v] = true
+		}
+	}
+
+	switch {
+	case len(texts) >= minRangeSamples:
+		constraints.MinLength, constraints.MaxLength = minLength, maxLength
+		observed = true
+	case minLength != nil && *minLength > 0:
+		constraints.MinLength = nonEmpty()
+		observed = true
+	}
+	if len(numbers) >= minRangeSamples {
+		constraints.Minimum, constraints.Maximum = minimum, maximum
+		observed = true
+	}
+
+	if !observed {
+		return nil
+	}
+	return constraints
+}
+
+func nonEmpty() *int {
+	one := 1
+	return &one
+}
+
+func minInt(current *int, value int) *int {
+	if current == nil || value < *current {
+		return &value
+	}
+	return current
+}
+
+func maxInt(current *int, value int) *int {
+	if current == nil || value > *current {
+		return &value
+	}
+	return current
+}
+
+func minFloat(current *float64, value float64) *float64 {
+	if current == nil || value < *current {
+		return &value
+	}
+	return current
+}
+
+func maxFloat(current *float64, value float64) *float64 {
+	if current == nil || value > *current {
+		return &value
+	}
+	return current
+}
@@ -0,0 +1,104 @@
+package parser_test
+
+import (
+	"testing"
+
+	"github.com/jguerreno/JSON-Converter/internal/parser"
+)
+
+func TestParseJSONConstraints(t *testing.T) {
+	// Two samples are too few for ranges: only non-empty strings and lists
+	// are kept.
+	jsonData := []byte(`[
+		{"name": "alice", "age": 31, "score": 1.5, "tags": ["a", "b"], "meta": {"k": 1}, "note": ""},
+		{"name": "bo", "age": 7, "score": 9, "tags": ["c"], "meta": {"k": 2}, "note": "x"}
+	]`)
+
+	classes, err := parser.ParseJSON(jsonData, "User")
+	if err != nil {
+		t.Fatalf("ParseJSON failed: %v", err)
+	}
+	user := findClass(classes, "UserItem")
+	if user == nil {
+		t.Fatal("Expected UserItem class to be created")
+	}
+
+	name := findField(user, "name")
+	if name.Constraints == nil || *name.Constraints.MinLength != 1 || name.Constraints.MaxLength != nil {
+		t.Errorf("name length bounds = %+v, want non-empty only", name.Constraints)
+	}
+
+	if age := findField(user, "age"); age.Constraints != nil {
+		t.Errorf("age constraints = %+v, want none from two samples", age.Constraints)
+	}
+
+	tags := findField(user, "tags")
+	if tags.Constraints == nil || *tags.Constraints.MinItems != 1 || tags.Constraints.MaxItems != nil {
+		t.Errorf("tags size bounds = %+v, want non-empty only", tags.Constraints)
+	}
+
+	if note := findField(user, "note"); note.Constraints != nil {
+		t.Errorf("note constraints = %+v, want none since it was empty once", note.Constraints)
+	}
+
+	if meta := findField(user, "meta"); meta.Constraints != nil {
+		t.Errorf("object fields should have no constraints, got %+v", meta.Constraints)
+	}
+}
+
+func TestParseJSONConstraintRanges(t *testing.T) {
+	jsonData := []byte(`[
+		{"code": "ab", "age": 31, "tags": []},
+		{"code": "abc", "age": 7, "tags": ["a"]},
+		{"code": "abcd", "age": 12, "tags": ["a", "b"]},
+		{"code": "bcd", "age": 40, "tags": ["a", "b", "c"]},
+		{"code": "cd", "age": 18, "tags": ["a", "b", "c", "d"]}
+	]`)
+
+	classes, err := parser.ParseJSON(jsonData, "User")
+	if err != nil {
+		t.Fatalf("ParseJSON failed: %v", err)
+	}
+	user := findClass(classes, "UserItem")
+
+	if code := findField(user, "code"); code.Constraints == nil || *code.Constraints.MinLength != 2 || *code.Constraints.MaxLength != 4 {
+		t.Errorf("code length bounds = %+v, want 2..4", code.Constraints)
+	}
+	age := findField(user, "age")
+	if age.Constraints == nil || *age.Constraints.Minimum != 7 || *age.Constraints.Maximum != 40 {
+		t.Errorf("age range = %+v, want 7..40", age.Constraints)
+	}
+	if age.Constraints != nil && age.Constraints.MinLength != nil {
+		t.Error("numeric fields should not get length bounds")
+	}
+	if tags := findField(user, "tags"); tags.Constraints == nil || *tags.Constraints.MinItems != 0 || *tags.Constraints.MaxItems != 4 {
+		t.Errorf("tags size bounds = %+v, want 0..4", tags.Constraints)
+	}
+}
+
+func TestParseJSONSchemaConstraints(t *testing.T) {
+	schema := []byte(`{
+		"type": "object",
+		"properties": {
+			"code": {"type": "string", "minLength": 3, "maxLength": 8},
+			"qty": {"type": "integer", "minimum": 1},
+			"lines": {"type": "array", "minItems": 1, "items": {"type": "string"}}
+		}
+	}`)
+
+	classes, err := parser.ParseJSONSchema(schema, "Order")
+	if err != nil {
+		t.Fatalf("ParseJSONSchema failed: %v", err)
+	}
+	order := findClass(classes, "Order")
+
+	if code := findField(order, "code"); code.Constraints == nil || *code.Constraints.MinLength != 3 || *code.Constraints.MaxLength != 8 {
+		t.Errorf("code constraints = %+v, want length 3..8", code.Constraints)
+	}
+	if qty := findField(order, "qty"); qty.Constraints == nil || *qty.Constraints.Minimum != 1 || qty.Constraints.Maximum != nil {
+		t.Errorf("qty constraints = %+v, want minimum 1", qty.Constraints)
+	}
+	if lines := findField(order, "lines"); lines.Constraints == nil || *lines.Constraints.MinItems != 1 {
+		t.Errorf("lines constraints = %+v, want minItems 1", lines.Constraints)
+	}
+}
@@ -0,0 +1,98 @@
+package parser
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/jguerreno/JSON-Converter/internal/models"
+)
+
+// ParseCSV infers a single row class from delimited text. The header row
+// provides the field names and every data row is a sample; empty cells
+// make a column optional, and a column empty in every row is an optional
+// string.
+func ParseCSV(csvData []byte, rootName string, delimiter rune, opts ...Option) ([]models.ClassDefinition, error) {
+	p, err := newProcessor(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	reader := csv.NewReader(bytes.NewReader(csvData))
+	reader.Comma = delimiter
+	reader.TrimLeadingSpace = true
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, fmt.Errorf("missing header row")
+	}
+
+	header := records[0]
+	for i, name := range header {
+		header[i] = strings.TrimSpace(strings.TrimPrefix(name, "\ufeff"))
+		if header[i] == "" {
+			return nil, fmt.Errorf("empty column name in header at position %d", i+1)
+		}
+	}
+
+	rows := []map[string]interface{}{}
+	for _, record := range records[1:] {
+		row := make(map[string]interface{})
+		for i, cell := range record {
+			if i < len(header) && strings.TrimSpace(cell) != "" {
+				row[header[i]] = csvCellValue(strings.TrimSpace(cell))
+			}
+		}
+		rows = append(rows, row)
+	}
+
+	if len(rows) == 0 {
+		row := make(map[string]interface{})
+		for _, name := range header {
+			row[name] = ""
+		}
+		rows = append(rows, row)
+	}
+
+	fields := mergeObjectTypes(rows)
+	for _, name := range header {
+		if _, ok := fields[name]; !ok {
+			fields[name] = models.FieldInfo{Value: "", IsOptional: true}
+		}
+	}
+	p.processObject(rootName, rows[0], fields, rootPath)
+
+	return p.result()
+}
+
+func csvCellValue(cell string) interface{} {
+	if zeroPadded(cell) {
+		// Codes such as zip codes or ids: a number would drop the zeros.
+		return cell
+	}
+	if n, err := strconv.ParseInt(cell, 10, 64); err == nil {
+		return float64(n)
+	}
+	if f, err := strconv.ParseFloat(cell, 64); err == nil {
+		return f
+	}
+	switch strings.ToLower(cell) {
+	case "true":
+		return true
+	case "false":
+		return false
+	}
+	return cell
+}
+
+// zeroPadded reports whether cell is an integer written with leading zeros,
+// such as "01234".
+func zeroPadded(cell string) bool {
+	digits := strings.TrimPrefix(strings.TrimPrefix(cell, "-"), "+")
+	return len(digits) > 1 && digits[0] == '0' && digits[1] >= '0' && digits[1] <= '9'
+}
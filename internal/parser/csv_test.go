@@ -0,0 +1,104 @@
+package parser_test
+
+import (
+	"testing"
+
+	"github.com/jguerreno/JSON-Converter/internal/parser"
+)
+
+func TestParseCSV(t *testing.T) {
+	csvData := []byte(`id,name,price,active,created,note
+1,Apple,1,true,2024-01-02,
+2,Pear,2.5,false,2024-02-03,ripe
+3,Plum,3,true,2024-03-04,
+`)
+
+	classes, err := parser.ParseCSV(csvData, "Row", ',')
+	if err != nil {
+		t.Fatalf("ParseCSV failed: %v", err)
+	}
+	if len(classes) != 1 {
+		t.Fatalf("Expected 1 class, got %d", len(classes))
+	}
+
+	row := &classes[0]
+	if row.Name != "Row" {
+		t.Errorf("Expected class name 'Row', got '%s'", row.Name)
+	}
+
+	tests := []struct {
+		column   string
+		typeName string
+		format   string
+		optional bool
+	}{
+		{"id", "int", "", false},
+		{"name", "string", "", false},
+		{"price", "float64", "", false},
+		{"active", "bool", "", false},
+		{"created", "string", "date", false},
+		{"note", "string", "", true},
+	}
+	for _, tt := range tests {
+		field := findField(row, tt.column)
+		if field == nil {
+			t.Errorf("Row is missing column %q", tt.column)
+			continue
+		}
+		if field.TypeName != tt.typeName || field.Format != tt.format || field.IsOptional != tt.optional {
+			t.Errorf("Row.%s = %+v, want type %s format %q optional %v", tt.column, field, tt.typeName, tt.format, tt.optional)
+		}
+	}
+}
+
+func TestParseTSVMixedColumn(t *testing.T) {
+	tsvData := []byte("code\tcount\n12\t1\nA7\t2\n")
+
+	classes, err := parser.ParseCSV(tsvData, "Row", '\t')
+	if err != nil {
+		t.Fatalf("ParseCSV failed: %v", err)
+	}
+
+	if code := findField(&classes[0], "code"); code == nil || code.TypeName != "string" {
+		t.Errorf("Expected mixed column to be inferred as string, got %+v", code)
+	}
+	if count := findField(&classes[0], "count"); count == nil || count.TypeName != "int" {
+		t.Errorf("Expected int column, got %+v", count)
+	}
+}
+
+func TestParseCSVEmptyColumnAndZeroPadding(t *testing.T) {
+	classes, err := parser.ParseCSV([]byte("a,b,c,zip\n1,,x,01234\n2,,y,90210\n"), "Row", ',')
+	if err != nil {
+		t.Fatalf("ParseCSV failed: %v", err)
+	}
+
+	if b := findField(&classes[0], "b"); b == nil || b.TypeName != "string" || !b.IsOptional {
+		t.Errorf("Expected an all-empty column to be an optional string, got %+v", b)
+	}
+	if zip := findField(&classes[0], "zip"); zip == nil || zip.TypeName != "string" {
+		t.Errorf("Expected zero-padded numbers to stay strings, got %+v", zip)
+	}
+	if a := findField(&classes[0], "a"); a == nil || a.TypeName != "int" {
+		t.Errorf("Expected int column, got %+v", a)
+	}
+}
+
+func TestParseCSVHeaderOnly(t *testing.T) {
+	classes, err := parser.ParseCSV([]byte("first name,last name\n"), "Person", ',')
+	if err != nil {
+		t.Fatalf("ParseCSV failed: %v", err)
+	}
+	if len(classes) != 1 || len(classes[0].Fields) != 2 {
+		t.Fatalf("Expected one class with 2 fields, got %+v", classes)
+	}
+	if field := findField(&classes[0], "first name"); field == nil || field.Name != "FirstName" {
+		t.Errorf("Expected FirstName field, got %+v", field)
+	}
+}
+
+func TestParseCSVEmpty(t *testing.T) {
+	if _, err := parser.ParseCSV([]byte(""), "Row", ','); err == nil {
+		t.Error("Expected error for missing header row")
+	}
+}
@@ -0,0 +1,78 @@
+package parser
+
+import (
+	"encoding/json"
+	"regexp"
+	"strconv"
+	"unicode/utf8"
+)
+
+// rootPath is the JSONPath of the document root every other path extends.
+const rootPath = "$"
+
+// maxExamples caps how many distinct sample values a field keeps, and
+// maxExampleLength how long each one may be before it is shortened.
+const (
+	maxExamples      = 3
+	maxExampleLength = 60
+)
+
+var pathIdentifier = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// memberPath appends an object key to a JSONPath, using bracket notation
+// for keys that are not plain identifiers.
+func memberPath(path, key string) string {
+	if pathIdentifier.MatchString(key) {
+		return path + "." + key
+	}
+	return path + "[" + strconv.Quote(key) + "]"
+}
+
+// elementPath is the JSONPath of every element of the array at path.
+func elementPath(path string) string {
+	return path + "[*]"
+}
+
+// collectExamples returns the first distinct scalar samples of a field,
+// encoded as JSON so that strings stay distinguishable from numbers. For
+// lists the samples are taken from the elements.
+func collectExamples(isList bool, samples []interface{}) []string {
+	if isList {
+		elements := []interface{}{}
+		for _, sample := range samples {
+			if list, ok := sample.([]interface{}); ok {
+				elements = append(elements, list...)
+			}
+		}
+		samples = elements
+	}
+
+	examples := []string{}
+	seen := make(map[string]bool)
+	for _, sample := range samples {
+		if !isScalar(sample) {
+			continue
+		}
+		encoded, err := json.Marshal(sample)
+		if err != nil || seen[string(encoded)] {
+			continue
+		}
+		seen[string(encoded)] = true
+		examples = append(examples, shortenExample(string(encoded)))
+		if len(examples) == maxExamples {
+			break
+		}
+	}
+	if len(examples) == 0 {
+		return nil
+	}
+	return examples
+}
+
+func shortenExample(example string) string {
+	if utf8.RuneCountInString(example) <= maxExampleLength {
+		return example
+	}
+	runes := []rune(example)
+	return string(runes[:maxExampleLength]) + "..."
+}
@@ -0,0 +1,167 @@
+package parser
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/BurntSushi/toml"
+
+	"github.com/jguerreno/JSON-Converter/internal/models"
+)
+
+// Format identifies the encoding of an input document.
+type Format string
+
+const (
+	FormatJSON    Format = "json"
+	FormatYAML    Format = "yaml"
+	FormatTOML    Format = "toml"
+	FormatXML     Format = "xml"
+	FormatCSV     Format = "csv"
+	FormatTSV     Format = "tsv"
+	FormatOpenAPI Format = "openapi"
+)
+
+var formatExtensions = map[string]Format{
+	".json": FormatJSON,
+	".yaml": FormatYAML,
+	".yml":  FormatYAML,
+	".toml": FormatTOML,
+	".xml":  FormatXML,
+	".csv":  FormatCSV,
+	".tsv":  FormatTSV,
+}
+
+// DetectFormat guesses the input format from a file name's extension.
+func DetectFormat(filename string) (Format, bool) {
+	format, ok := formatExtensions[strings.ToLower(filepath.Ext(filename))]
+	return format, ok
+}
+
+// Parse infers class definitions from a document in the given format.
+func Parse(data []byte, format Format, rootName string, opts ...Option) ([]models.ClassDefinition, error) {
+	switch format {
+	case FormatJSON:
+		return ParseJSON(data, rootName, opts...)
+	case FormatYAML:
+		return ParseYAML(data, rootName, opts...)
+	case FormatTOML:
+		return ParseTOML(data, rootName, opts...)
+	case FormatXML:
+		return ParseXML(data, rootName, opts...)
+	case FormatCSV:
+		return ParseCSV(data, rootName, ',', opts...)
+	case FormatTSV:
+		return ParseCSV(data, rootName, '\t', opts...)
+	case FormatOpenAPI:
+		p, err := newProcessor(opts)
+		if err != nil {
+			return nil, err
+		}
+		if len(p.overrides) > 0 {
+			return nil, fmt.Errorf("overrides are not supported for %s input", format)
+		}
+		return ParseOpenAPI(data)
+	default:
+		return nil, fmt.Errorf("input format '%s' not supported", format)
+	}
+}
+
+// ParseSamples infers class definitions from several documents that are
+// samples of the same root type, such as the files of a directory. JSON,
+// YAML and TOML samples are merged field by field; the other formats only
+// accept a single document.
+func ParseSamples(samples [][]byte, format Format, rootName string, opts ...Option) ([]models.ClassDefinition, error) {
+	if len(samples) == 1 {
+		return Parse(samples[0], format, rootName, opts...)
+	}
+	p, err := newProcessor(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var values []interface{}
+	switch format {
+	case FormatJSON:
+		for _, sample := range samples {
+			var value interface{}
+			if err := json.Unmarshal(sample, &value); err != nil {
+				return nil, err
+			}
+			values = append(values, value)
+		}
+	case FormatYAML:
+		return ParseYAML(bytes.Join(samples, []byte("\n---\n")), rootName, opts...)
+	case FormatTOML:
+		for _, sample := range samples {
+			var value map[string]interface{}
+			if err := toml.Unmarshal(sample, &value); err != nil {
+				return nil, err
+			}
+			values = append(values, normalizeValue(value))
+		}
+	default:
+		return nil, fmt.Errorf("input format '%s' does not support several samples", format)
+	}
+
+	p.processSamples(rootName, values, rootPath)
+
+	return p.result()
+}
+
+// isPlainDate reports whether t was decoded from a date without a time:
+// TOML marks local dates with their own zone, and YAML decodes a plain date
+// as midnight UTC.
+func isPlainDate(t time.Time) bool {
+	if t.Location().String() == "date-local" {
+		return true
+	}
+	return t.Location() == time.UTC && t.Hour() == 0 && t.Minute() == 0 && t.Second() == 0 && t.Nanosecond() == 0
+}
+
+// normalizeValue converts values decoded from YAML or TOML into the types
+// produced by encoding/json so that the rest of the parser only deals with
+// one shape.
+func normalizeValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, item := range v {
+			v[key] = normalizeValue(item)
+		}
+		return v
+	case map[interface{}]interface{}:
+		result := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			result[fmt.Sprint(key)] = normalizeValue(item)
+		}
+		return result
+	case []interface{}:
+		for i, item := range v {
+			v[i] = normalizeValue(item)
+		}
+		return v
+	case []map[string]interface{}:
+		result := make([]interface{}, len(v))
+		for i, item := range v {
+			result[i] = normalizeValue(item)
+		}
+		return result
+	case int:
+		return float64(v)
+	case int64:
+		return float64(v)
+	case uint64:
+		return float64(v)
+	case time.Time:
+		if isPlainDate(v) {
+			return v.Format(time.DateOnly)
+		}
+		return v.Format(time.RFC3339)
+	default:
+		return v
+	}
+}
@@ -0,0 +1,216 @@
+package parser_test
+
+import (
+	"testing"
+
+	"github.com/jguerreno/JSON-Converter/internal/parser"
+)
+
+func TestDetectFormat(t *testing.T) {
+	tests := []struct {
+		filename string
+		want     parser.Format
+		ok       bool
+	}{
+		{"input.json", parser.FormatJSON, true},
+		{"config.yaml", parser.FormatYAML, true},
+		{"config.YML", parser.FormatYAML, true},
+		{"Cargo.toml", parser.FormatTOML, true},
+		{"notes.txt", "", false},
+		{"", "", false},
+	}
+
+	for _, tt := range tests {
+		got, ok := parser.DetectFormat(tt.filename)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("DetectFormat(%q) = %q, %v, want %q, %v", tt.filename, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestParseYAML(t *testing.T) {
+	yamlData := []byte(`
+name: api
+replicas: 3
+ratio: 0.5
+debug: false
+database:
+  host: localhost
+  port: 5432
+hosts:
+  - a.example.com
+  - b.example.com
+`)
+
+	classes, err := parser.ParseYAML(yamlData, "Config")
+	if err != nil {
+		t.Fatalf("ParseYAML failed: %v", err)
+	}
+
+	config := findClass(classes, "Config")
+	if config == nil {
+		t.Fatal("Expected Config class to be created")
+	}
+	if findClass(classes, "Database") == nil {
+		t.Error("Expected nested Database class to be created")
+	}
+
+	fieldTypes := map[string]string{
+		"name":     "string",
+		"replicas": "int",
+		"ratio":    "float64",
+		"debug":    "bool",
+		"database": "Database",
+		"hosts":    "string",
+	}
+	for tag, want := range fieldTypes {
+		field := findField(config, tag)
+		if field == nil {
+			t.Errorf("Config is missing field %q", tag)
+			continue
+		}
+		if field.TypeName != want {
+			t.Errorf("Field %s: expected type %s, got %s", tag, want, field.TypeName)
+		}
+	}
+}
+
+func TestParseYAMLMultiDocument(t *testing.T) {
+	yamlData := []byte(`
+kind: Service
+name: web
+---
+kind: Service
+name: worker
+port: 8080
+`)
+
+	classes, err := parser.ParseYAML(yamlData, "Manifest")
+	if err != nil {
+		t.Fatalf("ParseYAML failed: %v", err)
+	}
+	if len(classes) != 1 {
+		t.Fatalf("Expected 1 class, got %d", len(classes))
+	}
+
+	manifest := &classes[0]
+	if manifest.Name != "Manifest" {
+		t.Errorf("Expected class name 'Manifest', got '%s'", manifest.Name)
+	}
+	if port := findField(manifest, "port"); port == nil || !port.IsOptional {
+		t.Error("Field 'port' SHOULD be optional (missing from one document)")
+	}
+	if name := findField(manifest, "name"); name == nil || name.IsOptional {
+		t.Error("Field 'name' should NOT be optional (present in all documents)")
+	}
+}
+
+func TestParseDates(t *testing.T) {
+	tests := []struct {
+		name   string
+		format parser.Format
+		data   string
+	}{
+		{"yaml", parser.FormatYAML, "day: 2020-01-01\nat: 2020-01-01T10:30:00Z\n"},
+		{"toml", parser.FormatTOML, "day = 2020-01-01\nat = 2020-01-01T10:30:00Z\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			classes, err := parser.Parse([]byte(tt.data), tt.format, "Root")
+			if err != nil {
+				t.Fatalf("Parse failed: %v", err)
+			}
+			if day := findField(&classes[0], "day"); day == nil || day.TypeName != "string" || day.Format != "date" {
+				t.Errorf("Expected a plain date to keep the date format, got %+v", day)
+			}
+			if at := findField(&classes[0], "at"); at == nil || at.Format != "date-time" {
+				t.Errorf("Expected a timestamp to be a date-time, got %+v", at)
+			}
+		})
+	}
+}
+
+func TestParseTOML(t *testing.T) {
+	tomlData := []byte(`
+title = "service"
+started = 2024-01-02T15:04:05Z
+
+[server]
+port = 8080
+tls = true
+
+[[backends]]
+url = "http://a"
+weight = 1.5
+
+[[backends]]
+url = "http://b"
+`)
+
+	classes, err := parser.ParseTOML(tomlData, "Config")
+	if err != nil {
+		t.Fatalf("ParseTOML failed: %v", err)
+	}
+
+	config := findClass(classes, "Config")
+	if config == nil {
+		t.Fatal("Expected Config class to be created")
+	}
+	if started := findField(config, "started"); started == nil || started.TypeName != "string" {
+		t.Errorf("Expected datetime to be parsed as string, got %+v", started)
+	}
+	if backends := findField(config, "backends"); backends == nil || !backends.IsList || backends.TypeName != "BackendsItem" {
+		t.Errorf("Expected backends to be a list of BackendsItem, got %+v", backends)
+	}
+
+	backend := findClass(classes, "BackendsItem")
+	if backend == nil {
+		t.Fatal("Expected BackendsItem class to be created")
+	}
+	if weight := findField(backend, "weight"); weight == nil || !weight.IsOptional {
+		t.Error("Field 'weight' SHOULD be optional (missing from one table)")
+	}
+
+	server := findClass(classes, "Server")
+	if server == nil {
+		t.Fatal("Expected Server class to be created")
+	}
+	if port := findField(server, "port"); port == nil || port.TypeName != "int" {
+		t.Errorf("Expected int port, got %+v", port)
+	}
+}
+
+func TestParseUnsupportedFormat(t *testing.T) {
+	if _, err := parser.Parse([]byte(`{}`), parser.Format("ini"), "Root"); err == nil {
+		t.Error("Expected error for unsupported format")
+	}
+}
+
+func TestParseSamples(t *testing.T) {
+	samples := [][]byte{
+		[]byte(`{"id": 1, "name": "web"}`),
+		[]byte(`{"id": 2, "name": "worker", "port": 8080}`),
+	}
+
+	classes, err := parser.ParseSamples(samples, parser.FormatJSON, "Service")
+	if err != nil {
+		t.Fatalf("ParseSamples failed: %v", err)
+	}
+	if len(classes) != 1 {
+		t.Fatalf("Expected 1 class, got %d", len(classes))
+	}
+	if port := findField(&classes[0], "port"); port == nil || !port.IsOptional {
+		t.Error("Field 'port' SHOULD be optional (missing from one sample)")
+	}
+	if name := findField(&classes[0], "name"); name == nil || name.IsOptional {
+		t.Error("Field 'name' should NOT be optional (present in all samples)")
+	}
+
+	if _, err := parser.ParseSamples(samples, parser.FormatXML, "Service"); err == nil {
+		t.Error("Expected error for several XML samples")
+	}
+	if _, err := parser.ParseSamples([][]byte{[]byte(`{`), samples[0]}, parser.FormatJSON, "Service"); err == nil {
+		t.Error("Expected error for an invalid sample")
+	}
+}
@@ -0,0 +1,172 @@
+package parser
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/jguerreno/JSON-Converter/internal/conventions"
+	"github.com/jguerreno/JSON-Converter/internal/models"
+)
+
+var openAPIMethods = []string{"get", "put", "post", "delete", "options", "head", "patch", "trace"}
+
+// ParseOpenAPI converts an OpenAPI 3.0/3.1 document, in JSON or YAML, into
+// class definitions. Every object under components/schemas becomes a class,
+// and inline request and response bodies are named after their operationId.
+func ParseOpenAPI(data []byte) ([]models.ClassDefinition, error) {
+	doc, err := decodeDocument(data)
+	if err != nil {
+		return nil, err
+	}
+
+	version, _ := doc["openapi"].(string)
+	if !strings.HasPrefix(version, "3.") {
+		return nil, fmt.Errorf("unsupported OpenAPI version %q, expected 3.x", version)
+	}
+
+	converter := newSchemaConverter(doc)
+
+	components, _ := doc["components"].(map[string]interface{})
+	schemas, _ := components["schemas"].(map[string]interface{})
+	for _, convert := range []bool{false, true} {
+		for _, name := range sortedKeys(schemas) {
+			if schema, ok := schemas[name].(map[string]interface{}); ok {
+				converter.convertNamed(name, schemaPointer("components/schemas", name), schema, convert)
+			}
+		}
+	}
+
+	paths, _ := doc["paths"].(map[string]interface{})
+	for _, path := range sortedKeys(paths) {
+		item, _ := paths[path].(map[string]interface{})
+		for _, method := range openAPIMethods {
+			operation, ok := item[method].(map[string]interface{})
+			if !ok {
+				continue
+			}
+			converter.convertOperation(operationName(method, path, operation), operation)
+		}
+	}
+
+	return converter.classes, nil
+}
+
+func (c *schemaConverter) convertOperation(name string, operation map[string]interface{}) {
+	if body := c.resolveObject(operation["requestBody"]); body != nil {
+		if schema := jsonContentSchema(body); schema != nil {
+			c.fieldType(name+"Request", schema)
+		}
+	}
+
+	responses, _ := operation["responses"].(map[string]interface{})
+	success := ""
+	for _, code := range sortedKeys(responses) {
+		if strings.HasPrefix(code, "2") {
+			success = code
+			break
+		}
+	}
+
+	for _, code := range sortedKeys(responses) {
+		response := c.resolveObject(responses[code])
+		if response == nil {
+			continue
+		}
+		schema := jsonContentSchema(response)
+		if schema == nil {
+			continue
+		}
+		responseName := name + conventions.ToPascalCase(code) + "Response"
+		if code == success {
+			responseName = name + "Response"
+		}
+		c.fieldType(responseName, schema)
+	}
+}
+
+// resolveObject returns the object behind value, following a $ref to a
+// reusable component such as #/components/responses/NotFound.
+func (c *schemaConverter) resolveObject(value interface{}) map[string]interface{} {
+	obj, ok := value.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	if ref, ok := obj["$ref"].(string); ok {
+		target, _, err := c.resolveRef(ref)
+		if err != nil {
+			return nil
+		}
+		return target
+	}
+	return obj
+}
+
+// jsonContentSchema picks the schema of the JSON media type of a request or
+// response body, falling back to the first declared media type.
+func jsonContentSchema(body map[string]interface{}) map[string]interface{} {
+	content, _ := body["content"].(map[string]interface{})
+	mediaTypes := sortedKeys(content)
+	if len(mediaTypes) == 0 {
+		return nil
+	}
+
+	selected := mediaTypes[0]
+	for _, mediaType := range mediaTypes {
+		if mediaType == "application/json" || strings.HasSuffix(mediaType, "+json") {
+			selected = mediaType
+			break
+		}
+	}
+
+	media, _ := content[selected].(map[string]interface{})
+	schema, _ := media["schema"].(map[string]interface{})
+	return schema
+}
+
+func operationName(method, path string, operation map[string]interface{}) string {
+	if id, ok := operation["operationId"].(string); ok && id != "" {
+		if strings.ContainsAny(id, "_- ") {
+			return conventions.ToPascalCase(id)
+		}
+		// operationIds are usually camelCase; keep their word boundaries.
+		r, size := utf8.DecodeRuneInString(id)
+		return string(unicode.ToUpper(r)) + id[size:]
+	}
+
+	name := conventions.ToPascalCase(method)
+	for _, segment := range strings.Split(path, "/") {
+		segment = strings.Trim(segment, "{}")
+		if segment != "" {
+			name += conventions.ToPascalCase(segment)
+		}
+	}
+	return name
+}
+
+// decodeDocument decodes a JSON or YAML document into the same generic
+// representation produced by encoding/json.
+func decodeDocument(data []byte) (map[string]interface{}, error) {
+	var value interface{}
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '{' {
+		if err := json.Unmarshal(data, &value); err != nil {
+			return nil, err
+		}
+	} else {
+		if err := yaml.Unmarshal(data, &value); err != nil {
+			return nil, err
+		}
+		value = normalizeValue(value)
+	}
+
+	doc, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("expected a document object at the top level")
+	}
+	return doc, nil
+}
@@ -0,0 +1,301 @@
+package parser_test
+
+import (
+	"testing"
+
+	"github.com/jguerreno/JSON-Converter/internal/models"
+	"github.com/jguerreno/JSON-Converter/internal/parser"
+)
+
+const petstoreYAML = `
+openapi: 3.0.3
+info:
+  title: Petstore
+  version: 1.0.0
+paths:
+  /pets:
+    post:
+      operationId: createPet
+      requestBody:
+        content:
+          application/json:
+            schema:
+              type: object
+              required: [name]
+              properties:
+                name:
+                  type: string
+                tag:
+                  type: string
+      responses:
+        "201":
+          content:
+            application/json:
+              schema:
+                $ref: '#/components/schemas/Pet'
+    get:
+      operationId: list_pets
+      responses:
+        "200":
+          content:
+            application/json:
+              schema:
+                type: array
+                items:
+                  type: object
+                  properties:
+                    id:
+                      type: integer
+components:
+  schemas:
+    Pet:
+      type: object
+      required: [id, name]
+      properties:
+        id:
+          type: integer
+          format: int64
+        name:
+          type: string
+        status:
+          type: string
+          enum: [available, sold]
+        born_at:
+          type: string
+          format: date-time
+        owner:
+          $ref: '#/components/schemas/Owner'
+        labels:
+          type: object
+          additionalProperties:
+            type: string
+    Owner:
+      type: object
+      properties:
+        email:
+          type: string
+          nullable: true
+`
+
+func findClass(classes []models.ClassDefinition, name string) *models.ClassDefinition {
+	for i := range classes {
+		if classes[i].Name == name {
+			return &classes[i]
+		}
+	}
+	return nil
+}
+
+func findField(class *models.ClassDefinition, jsonTag string) *models.FieldDefinition {
+	for i := range class.Fields {
+		if class.Fields[i].JSONTag == jsonTag {
+			return &class.Fields[i]
+		}
+	}
+	return nil
+}
+
+func TestParseOpenAPIComponents(t *testing.T) {
+	classes, err := parser.ParseOpenAPI([]byte(petstoreYAML))
+	if err != nil {
+		t.Fatalf("ParseOpenAPI failed: %v", err)
+	}
+
+	pet := findClass(classes, "Pet")
+	if pet == nil {
+		t.Fatal("Expected Pet class to be created")
+	}
+
+	tests := []struct {
+		jsonTag  string
+		want     models.FieldDefinition
+		optional bool
+	}{
+		{"id", models.FieldDefinition{TypeName: "int64"}, false},
+		{"name", models.FieldDefinition{TypeName: "string"}, false},
+		{"status", models.FieldDefinition{TypeName: "string"}, true},
+		{"born_at", models.FieldDefinition{TypeName: "string", Format: "date-time"}, true},
+		{"owner", models.FieldDefinition{TypeName: "Owner"}, true},
+		{"labels", models.FieldDefinition{TypeName: "string", IsMap: true}, true},
+	}
+
+	for _, tt := range tests {
+		field := findField(pet, tt.jsonTag)
+		if field == nil {
+			t.Errorf("Pet is missing field %q", tt.jsonTag)
+			continue
+		}
+		if field.TypeName != tt.want.TypeName || field.Format != tt.want.Format || field.IsMap != tt.want.IsMap {
+			t.Errorf("Pet.%s = %+v, want type %q format %q map %v", tt.jsonTag, field, tt.want.TypeName, tt.want.Format, tt.want.IsMap)
+		}
+		if field.IsOptional != tt.optional {
+			t.Errorf("Pet.%s IsOptional = %v, want %v", tt.jsonTag, field.IsOptional, tt.optional)
+		}
+	}
+
+	if status := findField(pet, "status"); status != nil && len(status.Enum) != 2 {
+		t.Errorf("Expected 2 enum values for Pet.status, got %v", status.Enum)
+	}
+
+	owner := findClass(classes, "Owner")
+	if owner == nil {
+		t.Fatal("Expected Owner class to be created")
+	}
+	if email := findField(owner, "email"); email == nil || !email.IsOptional {
+		t.Error("Expected nullable Owner.email to be optional")
+	}
+}
+
+func TestParseOpenAPIInlineBodies(t *testing.T) {
+	classes, err := parser.ParseOpenAPI([]byte(petstoreYAML))
+	if err != nil {
+		t.Fatalf("ParseOpenAPI failed: %v", err)
+	}
+
+	request := findClass(classes, "CreatePetRequest")
+	if request == nil {
+		t.Fatal("Expected CreatePetRequest class from inline request body")
+	}
+	if len(request.Fields) != 2 {
+		t.Errorf("Expected 2 fields in CreatePetRequest, got %d", len(request.Fields))
+	}
+
+	if findClass(classes, "ListPetsResponseItem") == nil {
+		t.Error("Expected ListPetsResponseItem class from inline array response")
+	}
+	if findClass(classes, "CreatePetResponse") != nil {
+		t.Error("Referenced response schemas should not create a new class")
+	}
+}
+
+func TestParseOpenAPIJSONAllOf(t *testing.T) {
+	doc := []byte(`{
+		"openapi": "3.1.0",
+		"components": {
+			"schemas": {
+				"Base": {"type": "object", "required": ["id"], "properties": {"id": {"type": "string", "format": "uuid"}}},
+				"Admin": {
+					"allOf": [
+						{"$ref": "#/components/schemas/Base"},
+						{"type": "object", "properties": {"level": {"type": ["integer", "null"]}}}
+					]
+				}
+			}
+		}
+	}`)
+
+	classes, err := parser.ParseOpenAPI(doc)
+	if err != nil {
+		t.Fatalf("ParseOpenAPI failed: %v", err)
+	}
+
+	admin := findClass(classes, "Admin")
+	if admin == nil {
+		t.Fatal("Expected Admin class to be created")
+	}
+	if id := findField(admin, "id"); id == nil || id.IsOptional || id.Format != "uuid" {
+		t.Errorf("Expected required uuid field id inherited from Base, got %+v", id)
+	}
+	if level := findField(admin, "level"); level == nil || level.TypeName != "int" || !level.IsOptional {
+		t.Errorf("Expected optional int field level, got %+v", level)
+	}
+}
+
+func TestParseOpenAPIRecursiveRefs(t *testing.T) {
+	doc := []byte(`{
+		"openapi": "3.1.0",
+		"components": {
+			"schemas": {
+				"Tree": {"type": "array", "items": {"$ref": "#/components/schemas/Tree"}},
+				"A": {"allOf": [{"$ref": "#/components/schemas/B"}, {"type": "object", "properties": {"a": {"type": "string"}}}]},
+				"B": {"allOf": [{"$ref": "#/components/schemas/A"}, {"type": "object", "properties": {"b": {"type": "integer"}}}]},
+				"Holder": {
+					"type": "object",
+					"properties": {
+						"tree": {"$ref": "#/components/schemas/Tree"},
+						"next": {"$ref": "#/components/schemas/Holder"}
+					}
+				}
+			}
+		}
+	}`)
+
+	classes, err := parser.ParseOpenAPI(doc)
+	if err != nil {
+		t.Fatalf("ParseOpenAPI failed: %v", err)
+	}
+
+	holder := findClass(classes, "Holder")
+	if holder == nil {
+		t.Fatal("Expected Holder class to be created")
+	}
+	if tree := findField(holder, "tree"); tree == nil || !tree.IsList || tree.TypeName != "interface{}" {
+		t.Errorf("Expected recursive array Tree to become a list of interface{}, got %+v", tree)
+	}
+	if next := findField(holder, "next"); next == nil || next.TypeName != "Holder" {
+		t.Errorf("Expected self reference to keep the class name, got %+v", next)
+	}
+
+	for _, name := range []string{"A", "B"} {
+		class := findClass(classes, name)
+		if class == nil {
+			t.Fatalf("Expected %s class to be created", name)
+		}
+		if findField(class, "a") == nil || findField(class, "b") == nil {
+			t.Errorf("Expected %s to merge fields a and b, got %+v", name, class.Fields)
+		}
+	}
+}
+
+func TestParseOpenAPIInlineObjectNames(t *testing.T) {
+	doc := []byte(`{
+		"openapi": "3.1.0",
+		"components": {
+			"schemas": {
+				"A": {"type": "object", "properties": {
+					"address": {"type": "object", "properties": {"street": {"type": "string"}}},
+					"home": {"$ref": "#/components/schemas/Address"}
+				}},
+				"Address": {"type": "object", "properties": {"city": {"type": "string"}}},
+				"B": {"type": "object", "properties": {
+					"address": {"type": "object", "properties": {"zip": {"type": "string"}}}
+				}},
+				"C": {"type": "object", "properties": {
+					"address": {"type": "object", "properties": {"zip": {"type": "string"}}}
+				}}
+			}
+		}
+	}`)
+
+	classes, err := parser.ParseOpenAPI(doc)
+	if err != nil {
+		t.Fatalf("ParseOpenAPI failed: %v", err)
+	}
+
+	tests := []struct {
+		class, field, typeName, property string
+	}{
+		{"A", "address", "AAddress", "street"},
+		{"A", "home", "Address", "city"},
+		{"B", "address", "BAddress", "zip"},
+		{"C", "address", "BAddress", "zip"},
+	}
+	for _, tt := range tests {
+		field := findField(findClass(classes, tt.class), tt.field)
+		if field == nil || field.TypeName != tt.typeName {
+			t.Errorf("Expected %s.%s to be %s, got %+v", tt.class, tt.field, tt.typeName, field)
+			continue
+		}
+		class := findClass(classes, tt.typeName)
+		if class == nil || len(class.Fields) != 1 || findField(class, tt.property) == nil {
+			t.Errorf("Expected %s to hold only %s, got %+v", tt.typeName, tt.property, class)
+		}
+	}
+}
+
+func TestParseOpenAPIRejectsSwagger2(t *testing.T) {
+	_, err := parser.ParseOpenAPI([]byte(`{"swagger": "2.0"}`))
+	if err == nil {
+		t.Error("Expected error for Swagger 2.0 document")
+	}
+}
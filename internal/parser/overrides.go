@@ -0,0 +1,264 @@
+package parser
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/jguerreno/JSON-Converter/internal/models"
+)
+
+// Option configures how samples are turned into class definitions.
+type Option func(*parseConfig)
+
+type parseConfig struct {
+	ctx       context.Context
+	overrides map[string]string
+}
+
+// WithContext stops parsing once ctx is done, in which case the parse
+// fails with ctx.Err().
+func WithContext(ctx context.Context) Option {
+	return func(c *parseConfig) {
+		c.ctx = ctx
+	}
+}
+
+// WithOverrides corrects inference at the given JSONPaths, written the way
+// they appear in ClassDefinition.Path and FieldDefinition.Path ($.a.b,
+// $.items[*], $["odd key"]; a leading "$." may be left out). Each path maps
+// to one of:
+//
+//	string, int, int64, float64, bool, any   a scalar type
+//	datetime, date, uuid                     a string with that format
+//	map<string,T>                            a map whose values are T
+//	skip                                     leave the field out
+//	anything else                            the name of the class at path
+//
+// A class name given for an array field names the class of its elements.
+// Every override must match a value in the input.
+func WithOverrides(overrides map[string]string) Option {
+	return func(c *parseConfig) {
+		if c.overrides == nil {
+			c.overrides = map[string]string{}
+		}
+		for path, spec := range overrides {
+			c.overrides[path] = spec
+		}
+	}
+}
+
+type overrideKind int
+
+const (
+	overrideType overrideKind = iota
+	overrideMap
+	overrideClass
+	overrideSkip
+)
+
+// override is a parsed WithOverrides value. For overrideMap, typeName and
+// format (or className) describe the map values.
+type override struct {
+	kind      overrideKind
+	typeName  string
+	format    string
+	className string
+}
+
+var overrideScalars = map[string]override{
+	"string":    {typeName: "string"},
+	"int":       {typeName: "int"},
+	"integer":   {typeName: "int"},
+	"int64":     {typeName: "int64"},
+	"float":     {typeName: "float64"},
+	"float64":   {typeName: "float64"},
+	"number":    {typeName: "float64"},
+	"bool":      {typeName: "bool"},
+	"boolean":   {typeName: "bool"},
+	"any":       {typeName: "interface{}"},
+	"datetime":  {typeName: "string", format: "date-time"},
+	"date-time": {typeName: "string", format: "date-time"},
+	"date":      {typeName: "string", format: "date"},
+	"uuid":      {typeName: "string", format: "uuid"},
+}
+
+var (
+	overrideMapPattern   = regexp.MustCompile(`^map\s*<\s*string\s*,\s*(.+?)\s*>$`)
+	overrideClassPattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+)
+
+func parseOverride(spec string) (override, error) {
+	spec = strings.TrimSpace(spec)
+	if spec == "skip" {
+		return override{kind: overrideSkip}, nil
+	}
+	if scalar, ok := overrideScalars[spec]; ok {
+		return scalar, nil
+	}
+	if match := overrideMapPattern.FindStringSubmatch(spec); match != nil {
+		value, err := parseOverride(match[1])
+		if err != nil || (value.kind != overrideType && value.kind != overrideClass) {
+			return override{}, fmt.Errorf("unsupported map value type '%s'", match[1])
+		}
+		value.kind = overrideMap
+		return value, nil
+	}
+	if overrideClassPattern.MatchString(spec) {
+		return override{kind: overrideClass, className: spec}, nil
+	}
+	return override{}, fmt.Errorf("unsupported type '%s'", spec)
+}
+
+func normalizeOverridePath(path string) string {
+	path = strings.TrimSpace(path)
+	if path == rootPath || strings.HasPrefix(path, rootPath+".") || strings.HasPrefix(path, rootPath+"[") {
+		return path
+	}
+	return rootPath + "." + path
+}
+
+// processor accumulates the classes inferred from a document, applying the
+// overrides that match the paths it visits.
+type processor struct {
+	ctx       context.Context
+	classes   []models.ClassDefinition
+	overrides map[string]override
+	matched   map[string]bool
+	// notObjects holds the class overrides matched by a value that is not
+	// an object, which therefore had no class to name.
+	notObjects map[string]bool
+}
+
+func newProcessor(opts []Option) (*processor, error) {
+	config := &parseConfig{ctx: context.Background()}
+	for _, opt := range opts {
+		opt(config)
+	}
+
+	p := &processor{
+		ctx:        config.ctx,
+		classes:    []models.ClassDefinition{},
+		overrides:  map[string]override{},
+		matched:    map[string]bool{},
+		notObjects: map[string]bool{},
+	}
+	for path, spec := range config.overrides {
+		o, err := parseOverride(spec)
+		if err != nil {
+			return nil, fmt.Errorf("invalid override for %s: %w", path, err)
+		}
+		p.overrides[normalizeOverridePath(path)] = o
+	}
+	return p, nil
+}
+
+// override returns the override at path, marking it as used.
+func (p *processor) override(path string) (override, bool) {
+	o, ok := p.overrides[path]
+	if ok {
+		p.matched[path] = true
+	}
+	return o, ok
+}
+
+// className returns the name of the class inferred at path: the one given
+// by an override for path, or for the array holding it, or else name.
+func (p *processor) className(name, path string) string {
+	if o, ok := p.override(path); ok && o.kind == overrideClass {
+		return o.className
+	}
+	if array, ok := strings.CutSuffix(path, "[*]"); ok {
+		if o, ok := p.override(array); ok && o.kind == overrideClass {
+			return o.className
+		}
+	}
+	return name
+}
+
+// fieldOverride returns the override that replaces the inferred type of the
+// field at path. Scalar types may also be given for the elements of a list.
+// Class names are left to className.
+func (p *processor) fieldOverride(path string, value interface{}) (override, bool) {
+	o, ok := p.override(path)
+	if !ok {
+		if _, isList := value.([]interface{}); isList {
+			if element, found := p.overrides[elementPath(path)]; found && element.kind == overrideType {
+				o, ok = p.override(elementPath(path))
+			}
+		}
+	}
+	if ok && o.kind == overrideClass && !holdsObjects(value) {
+		p.notObjects[path] = true
+	}
+	if !ok || o.kind == overrideClass {
+		return override{}, false
+	}
+	return o, true
+}
+
+// holdsObjects reports whether value is an object or a list holding one,
+// whose class an override may name.
+func holdsObjects(value interface{}) bool {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		return true
+	case []interface{}:
+		for _, element := range v {
+			if holdsObjects(element) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// processMapValues returns the value type of a field overridden as a map.
+// A class named for the values is inferred from the values of every sample,
+// found at path.*.
+func (p *processor) processMapValues(o override, samples []interface{}, path string) (string, string) {
+	if o.className == "" {
+		return o.typeName, o.format
+	}
+
+	values := []map[string]interface{}{}
+	for _, sample := range samples {
+		obj, ok := sample.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for _, key := range sortedKeys(obj) {
+			if value, ok := obj[key].(map[string]interface{}); ok {
+				values = append(values, value)
+			}
+		}
+	}
+	return p.processObject(o.className, nil, mergeObjectTypes(values), path+".*"), ""
+}
+
+// result returns the inferred classes, or an error naming the overrides
+// that matched nothing, which are most likely misspelled, or that name a
+// class for a value that is not an object. A parse that was cancelled
+// returns the error of its context.
+func (p *processor) result() ([]models.ClassDefinition, error) {
+	if err := p.ctx.Err(); err != nil {
+		return nil, err
+	}
+	var unmatched []string
+	for path := range p.overrides {
+		if !p.matched[path] {
+			unmatched = append(unmatched, path)
+		}
+	}
+	if len(unmatched) > 0 {
+		sort.Strings(unmatched)
+		return nil, fmt.Errorf("override paths match nothing in the input: %s", strings.Join(unmatched, ", "))
+	}
+	if len(p.notObjects) > 0 {
+		paths := sortedKeys(p.notObjects)
+		return nil, fmt.Errorf("class name overrides need an object, but these paths are not objects: %s", strings.Join(paths, ", "))
+	}
+	return p.classes, nil
+}
@@ -0,0 +1,122 @@
+package parser_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/jguerreno/JSON-Converter/internal/parser"
+)
+
+const orderJSON = `{
+	"id": 7,
+	"created_at": "yesterday",
+	"metadata": {"source": "web", "campaign": "spring"},
+	"prices": {"eu": {"amount": 1.5}, "us": {"amount": 2, "tax": 0.1}},
+	"items": [{"sku": "a-1", "qty": 2}],
+	"tags": ["2024-01-02"],
+	"customer": {"name": "Ann"},
+	"legacy_field": 3
+}`
+
+func TestParseJSONOverrides(t *testing.T) {
+	classes, err := parser.ParseJSON([]byte(orderJSON), "Order", parser.WithOverrides(map[string]string{
+		"$.id":           "int64",
+		"$.created_at":   "datetime",
+		"$.metadata":     "map<string,string>",
+		"$.prices":       "map<string, Price>",
+		"$.items[*]":     "OrderLine",
+		"$.tags[*]":      "date",
+		"customer":       "Buyer",
+		"$.legacy_field": "skip",
+	}))
+	if err != nil {
+		t.Fatalf("ParseJSON failed: %v", err)
+	}
+
+	order := findClass(classes, "Order")
+	if order == nil {
+		t.Fatal("Expected Order class to be created")
+	}
+
+	tests := []struct {
+		jsonTag  string
+		typeName string
+		format   string
+		isList   bool
+		isMap    bool
+	}{
+		{"id", "int64", "", false, false},
+		{"created_at", "string", "date-time", false, false},
+		{"metadata", "string", "", false, true},
+		{"prices", "Price", "", false, true},
+		{"items", "OrderLine", "", true, false},
+		{"tags", "string", "date", true, false},
+		{"customer", "Buyer", "", false, false},
+	}
+	for _, tt := range tests {
+		field := findField(order, tt.jsonTag)
+		if field == nil {
+			t.Errorf("Expected field %s", tt.jsonTag)
+			continue
+		}
+		if field.TypeName != tt.typeName || field.Format != tt.format || field.IsList != tt.isList || field.IsMap != tt.isMap {
+			t.Errorf("Order.%s = %+v, want type %q format %q list %v map %v", tt.jsonTag, field, tt.typeName, tt.format, tt.isList, tt.isMap)
+		}
+	}
+	if findField(order, "legacy_field") != nil {
+		t.Error("Field 'legacy_field' should be skipped")
+	}
+
+	for _, name := range []string{"OrderLine", "Buyer"} {
+		if findClass(classes, name) == nil {
+			t.Errorf("Expected %s class to be created", name)
+		}
+	}
+	if findClass(classes, "Metadata") != nil || findClass(classes, "Prices") != nil {
+		t.Error("Map fields should not produce a class for their keys")
+	}
+	price := findClass(classes, "Price")
+	if price == nil || price.Path != "$.prices.*" {
+		t.Fatalf("Expected Price class at $.prices.*, got %+v", price)
+	}
+	if tax := findField(price, "tax"); tax == nil || !tax.IsOptional {
+		t.Error("Field 'tax' SHOULD be optional (missing from one map value)")
+	}
+}
+
+func TestParseOverridesRootClass(t *testing.T) {
+	classes, err := parser.ParseJSON([]byte(`[{"id": 1}]`), "Root", parser.WithOverrides(map[string]string{"$[*]": "Event"}))
+	if err != nil {
+		t.Fatalf("ParseJSON failed: %v", err)
+	}
+	if findClass(classes, "Event") == nil {
+		t.Errorf("Expected the element class to be named Event, got %+v", classes)
+	}
+}
+
+func TestParseOverridesErrors(t *testing.T) {
+	tests := []struct {
+		name      string
+		overrides map[string]string
+		wantErr   string
+	}{
+		{"unmatched path", map[string]string{"$.idd": "int", "$.id": "int64"}, "override paths match nothing in the input: $.idd"},
+		{"unsupported type", map[string]string{"$.id": "list of int"}, "invalid override for $.id: unsupported type 'list of int'"},
+		{"unsupported map value", map[string]string{"$.id": "map<string,skip>"}, "unsupported map value type 'skip'"},
+		{"class for a scalar", map[string]string{"$.id": "Identifier"}, "these paths are not objects: $.id"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parser.ParseJSON([]byte(`{"id": 1}`), "Root", parser.WithOverrides(tt.overrides))
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("ParseJSON() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+
+	_, err := parser.Parse([]byte(`openapi: 3.0.0`), parser.FormatOpenAPI, "Root", parser.WithOverrides(map[string]string{"$.id": "int"}))
+	if err == nil || !strings.Contains(err.Error(), "not supported for openapi input") {
+		t.Errorf("Expected overrides to be rejected for OpenAPI input, got %v", err)
+	}
+}
@@ -2,35 +2,41 @@ package parser
 
 import (
 	"encoding/json"
+	"regexp"
+	"time"
 
 	"github.com/jguerreno/JSON-Converter/internal/conventions"
 	"github.com/jguerreno/JSON-Converter/internal/models"
 )
 
-func ParseJSON(jsonData []byte, rootName string) ([]models.ClassDefinition, error) {
+func ParseJSON(jsonData []byte, rootName string, opts ...Option) ([]models.ClassDefinition, error) {
+	p, err := newProcessor(opts)
+	if err != nil {
+		return nil, err
+	}
+
 	var data interface{}
 	if err := json.Unmarshal(jsonData, &data); err != nil {
 		return nil, err
 	}
 
-	classes := []models.ClassDefinition{}
-	processValue(rootName, data, &classes)
+	p.processValue(rootName, data, rootPath)
 
-	return classes, nil
+	return p.result()
 }
 
-func processValue(name string, value interface{}, classes *[]models.ClassDefinition) string {
+func (p *processor) processValue(name string, value interface{}, path string) string {
 	switch v := value.(type) {
 	case map[string]interface{}:
-		return processObject(name, v, nil, classes)
+		return p.processObject(name, v, nil, path)
 
 	case []interface{}:
 		if len(v) > 0 {
 			if _, isObject := v[0].(map[string]interface{}); isObject {
-				return processArrayElements(name, v, classes)
+				return p.processArrayElements(name, v, path)
 			}
 
-			return processValue(name+"Item", v[0], classes)
+			return p.processValue(name+"Item", v[0], elementPath(path))
 		}
 		return "interface{}"
 
@@ -51,8 +57,35 @@ func processValue(name string, value interface{}, classes *[]models.ClassDefinit
 	}
 }
 
-func processObject(name string, obj map[string]interface{}, mergedFields map[string]models.FieldInfo, classes *[]models.ClassDefinition) string {
-	className := conventions.ToPascalCase(name)
+// processSamples merges several samples of the same value into one type,
+// the way elements of an array of objects are merged.
+func (p *processor) processSamples(name string, samples []interface{}, path string) string {
+	switch len(samples) {
+	case 0:
+		return "interface{}"
+	case 1:
+		return p.processValue(name, samples[0], path)
+	}
+
+	objects := make([]map[string]interface{}, 0, len(samples))
+	for _, sample := range samples {
+		if obj, ok := sample.(map[string]interface{}); ok {
+			objects = append(objects, obj)
+		}
+	}
+	if len(objects) != len(samples) {
+		return p.processValue(name, samples[0], path)
+	}
+
+	return p.processObject(name, objects[0], mergeObjectTypes(objects), path)
+}
+
+func (p *processor) processObject(name string, obj map[string]interface{}, mergedFields map[string]models.FieldInfo, path string) string {
+	className := p.className(conventions.ToPascalCase(name), path)
+	if p.ctx.Err() != nil {
+		// Cancelled: result reports the error, so skip the rest.
+		return className
+	}
 	fields := []models.FieldDefinition{}
 
 	if mergedFields == nil {
@@ -61,50 +94,90 @@ func processObject(name string, obj map[string]interface{}, mergedFields map[str
 			mergedFields[key] = models.FieldInfo{
 				Value:      value,
 				IsOptional: value == nil,
+				Samples:    []interface{}{value},
 			}
 		}
 	}
 
-	for key, fieldData := range mergedFields {
+	for _, key := range sortedKeys(mergedFields) {
+		fieldData := mergedFields[key]
 		fieldName := conventions.ToPascalCase(key)
-		var typeName string
-		isList := false
-		value := fieldData.Value
-
-		switch v := value.(type) {
-		case []interface{}:
-			isList = true
-			if len(v) > 0 {
-				if _, isObject := v[0].(map[string]interface{}); isObject {
-					typeName = processArrayElements(fieldName, v, classes)
-				} else {
-					typeName = processValue(fieldName, v[0], classes)
-				}
-			} else {
-				typeName = "interface{}"
-			}
+		fieldPath := memberPath(path, key)
+
+		var typeName, format string
+		var isList, isMap bool
+		o, overridden := p.fieldOverride(fieldPath, fieldData.Value)
+		switch {
+		case overridden && o.kind == overrideSkip:
+			continue
+		case overridden && o.kind == overrideType:
+			_, isList = fieldData.Value.([]interface{})
+			typeName, format = o.typeName, o.format
+		case overridden && o.kind == overrideMap:
+			isMap = true
+			typeName, format = p.processMapValues(o, fieldData.Samples, fieldPath)
 		default:
-			typeName = processValue(fieldName, v, classes)
+			typeName, isList, format = p.processField(fieldName, fieldData, fieldPath)
 		}
 
 		fields = append(fields, models.FieldDefinition{
-			Name:       fieldName,
-			JSONTag:    key,
-			TypeName:   typeName,
-			IsList:     isList,
-			IsOptional: fieldData.IsOptional || value == nil,
+			Name:        fieldName,
+			JSONTag:     key,
+			TypeName:    typeName,
+			IsList:      isList,
+			IsMap:       isMap,
+			IsOptional:  fieldData.IsOptional || fieldData.Value == nil,
+			Format:      format,
+			Constraints: inferConstraints(typeName, isList, fieldData.Samples),
+			Examples:    collectExamples(isList, fieldData.Samples),
+			Path:        fieldPath,
 		})
 	}
 
-	*classes = append(*classes, models.ClassDefinition{
+	p.classes = append(p.classes, models.ClassDefinition{
 		Name:   className,
 		Fields: fields,
+		Path:   path,
 	})
 
 	return className
 }
 
-func processArrayElements(name string, array []interface{}, classes *[]models.ClassDefinition) string {
+// processField infers the type of a field from every sample seen for it, so
+// that nested objects and list elements are merged across samples too.
+func (p *processor) processField(name string, info models.FieldInfo, path string) (string, bool, string) {
+	switch info.Value.(type) {
+	case []interface{}:
+		elements := []interface{}{}
+		for _, sample := range info.Samples {
+			if list, ok := sample.([]interface{}); ok {
+				elements = append(elements, list...)
+			}
+		}
+		merged := mergeValues(elements)
+		if merged == nil {
+			return "interface{}", true, ""
+		}
+		if _, isObject := merged.(map[string]interface{}); isObject {
+			return p.processArrayElements(name, elements, path), true, ""
+		}
+		return p.processValue(name, merged, elementPath(path)), true, detectFormat(elements)
+
+	case map[string]interface{}:
+		objects := []interface{}{}
+		for _, sample := range info.Samples {
+			if obj, ok := sample.(map[string]interface{}); ok {
+				objects = append(objects, obj)
+			}
+		}
+		return p.processSamples(name, objects, path), false, ""
+
+	default:
+		return p.processValue(name, info.Value, path), false, detectFormat(info.Samples)
+	}
+}
+
+func (p *processor) processArrayElements(name string, array []interface{}, path string) string {
 	objects := make([]map[string]interface{}, 0, len(array))
 	for _, item := range array {
 		if obj, ok := item.(map[string]interface{}); ok {
@@ -117,7 +190,7 @@ func processArrayElements(name string, array []interface{}, classes *[]models.Cl
 	}
 
 	mergedFields := mergeObjectTypes(objects)
-	return processObject(name+"Item", objects[0], mergedFields, classes)
+	return p.processObject(name+"Item", objects[0], mergedFields, elementPath(path))
 }
 
 func mergeObjectTypes(objects []map[string]interface{}) map[string]models.FieldInfo {
@@ -125,25 +198,152 @@ func mergeObjectTypes(objects []map[string]interface{}) map[string]models.FieldI
 		return map[string]models.FieldInfo{}
 	}
 	fieldCount := make(map[string]int)
-	fieldValues := make(map[string]interface{})
+	fieldSamples := make(map[string][]interface{})
 
 	for _, obj := range objects {
 		for key, value := range obj {
 			fieldCount[key]++
-			if _, exists := fieldValues[key]; !exists {
-				fieldValues[key] = value
-			}
+			fieldSamples[key] = append(fieldSamples[key], value)
 		}
 	}
 
 	totalObjects := len(objects)
 	result := make(map[string]models.FieldInfo)
 	for key, count := range fieldCount {
+		samples := fieldSamples[key]
 		result[key] = models.FieldInfo{
-			Value:      fieldValues[key],
-			IsOptional: count < totalObjects,
+			Value:      mergeValues(samples),
+			IsOptional: count < totalObjects || containsNil(samples),
+			Samples:    samples,
 		}
 	}
 
 	return result
 }
+
+// mixedValue stands for samples whose types cannot be reconciled; it is
+// inferred as interface{}.
+type mixedValue struct{}
+
+// mergeValues reduces the samples of one field to a representative value:
+// nulls give way to concrete values, integers widen to floats, strings
+// absorb other scalars and anything else conflicting becomes mixedValue.
+func mergeValues(values []interface{}) interface{} {
+	var merged interface{}
+	for _, value := range values {
+		merged = mergeValue(merged, value)
+	}
+	return merged
+}
+
+func mergeValue(current, next interface{}) interface{} {
+	if current == nil {
+		return next
+	}
+	if next == nil {
+		return current
+	}
+
+	switch c := current.(type) {
+	case mixedValue:
+		return c
+	case string:
+		if isScalar(next) {
+			return c
+		}
+	case float64:
+		if n, ok := next.(float64); ok {
+			if c == float64(int64(c)) {
+				return n
+			}
+			return c
+		}
+	case bool:
+		if _, ok := next.(bool); ok {
+			return c
+		}
+	case map[string]interface{}:
+		if _, ok := next.(map[string]interface{}); ok {
+			return c
+		}
+	case []interface{}:
+		if n, ok := next.([]interface{}); ok {
+			if len(c) == 0 {
+				return n
+			}
+			return c
+		}
+	}
+
+	if s, ok := next.(string); ok && isScalar(current) {
+		return s
+	}
+	return mixedValue{}
+}
+
+func isScalar(value interface{}) bool {
+	switch value.(type) {
+	case string, float64, bool:
+		return true
+	default:
+		return false
+	}
+}
+
+func containsNil(values []interface{}) bool {
+	for _, value := range values {
+		if value == nil {
+			return true
+		}
+	}
+	return false
+}
+
+var uuidPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// stringFormats are checked in order; a format is reported only when every
+// string sample of a field matches it.
+var stringFormats = []struct {
+	name  string
+	match func(string) bool
+}{
+	{"date-time", func(s string) bool {
+		_, err := time.Parse(time.RFC3339, s)
+		return err == nil
+	}},
+	{"date", func(s string) bool {
+		_, err := time.Parse(time.DateOnly, s)
+		return err == nil
+	}},
+	{"uuid", uuidPattern.MatchString},
+}
+
+func detectFormat(samples []interface{}) string {
+	values := []string{}
+	for _, sample := range samples {
+		switch v := sample.(type) {
+		case nil:
+		case string:
+			values = append(values, v)
+		default:
+			return ""
+		}
+	}
+	if len(values) == 0 {
+		return ""
+	}
+
+	for _, format := range stringFormats {
+		matched := true
+		for _, value := range values {
+			if !format.match(value) {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return format.name
+		}
+	}
+	return ""
+}
@@ -1,6 +1,9 @@
 package parser_test
 
 import (
+	"context"
+	"errors"
+	"strings"
 	"testing"
 
 	"github.com/jguerreno/JSON-Converter/internal/models"
@@ -295,3 +298,134 @@ func TestParseJSONArrayWithOptionalFields(t *testing.T) {
 		t.Error("Field 'Email' SHOULD be optional (not present in all elements)")
 	}
 }
+
+func TestParseJSONArrayMergesTypes(t *testing.T) {
+	jsonData := []byte(`{
+		"points": [
+			{"x": 1, "label": null, "meta": {"a": 1}},
+			{"x": 2.5, "label": "B", "meta": {"b": "two"}}
+		]
+	}`)
+
+	classes, err := parser.ParseJSON(jsonData, "Chart")
+	if err != nil {
+		t.Fatalf("ParseJSON failed: %v", err)
+	}
+
+	var points, meta *models.ClassDefinition
+	for i := range classes {
+		switch classes[i].Name {
+		case "PointsItem":
+			points = &classes[i]
+		case "Meta":
+			meta = &classes[i]
+		}
+	}
+	if points == nil || meta == nil {
+		t.Fatalf("Expected PointsItem and Meta classes, got %+v", classes)
+	}
+
+	for _, field := range points.Fields {
+		switch field.Name {
+		case "X":
+			if field.TypeName != "float64" {
+				t.Errorf("Field X: expected int and float samples to merge to float64, got %s", field.TypeName)
+			}
+		case "Label":
+			if field.TypeName != "string" || !field.IsOptional {
+				t.Errorf("Field Label: expected optional string, got %+v", field)
+			}
+		}
+	}
+
+	if len(meta.Fields) != 2 {
+		t.Errorf("Expected nested Meta objects to be merged into 2 fields, got %d", len(meta.Fields))
+	}
+	for _, field := range meta.Fields {
+		if !field.IsOptional {
+			t.Errorf("Field Meta.%s SHOULD be optional (present in one sample only)", field.Name)
+		}
+	}
+}
+
+func TestParseJSONStringFormats(t *testing.T) {
+	jsonData := []byte(`{
+		"created_at": "2024-05-01T10:00:00Z",
+		"birthday": "1990-12-31",
+		"id": "3f2504e0-4f89-11d3-9a0c-0305e82c3301",
+		"name": "Alice"
+	}`)
+
+	classes, err := parser.ParseJSON(jsonData, "User")
+	if err != nil {
+		t.Fatalf("ParseJSON failed: %v", err)
+	}
+
+	formats := map[string]string{
+		"CreatedAt": "date-time",
+		"Birthday":  "date",
+		"Id":        "uuid",
+		"Name":      "",
+	}
+	for _, field := range classes[0].Fields {
+		if field.Format != formats[field.Name] {
+			t.Errorf("Field %s: expected format %q, got %q", field.Name, formats[field.Name], field.Format)
+		}
+	}
+}
+
+func TestParseJSONExamplesAndPaths(t *testing.T) {
+	jsonData := []byte(`{
+		"users": [
+			{"email": "alice@example.com", "tags": ["a", "b"], "home page": "a.example"},
+			{"email": "bob@example.com", "tags": ["a"]},
+			{"email": "alice@example.com", "tags": []}
+		]
+	}`)
+
+	classes, err := parser.ParseJSON(jsonData, "Root")
+	if err != nil {
+		t.Fatalf("ParseJSON failed: %v", err)
+	}
+
+	classPaths := map[string]string{
+		"UsersItem": "$.users[*]",
+		"Root":      "$",
+	}
+	fieldPaths := map[string]string{
+		"Email":    "$.users[*].email",
+		"Tags":     "$.users[*].tags",
+		"HomePage": `$.users[*]["home page"]`,
+		"Users":    "$.users",
+	}
+	examples := map[string][]string{
+		"Email":    {`"alice@example.com"`, `"bob@example.com"`},
+		"Tags":     {`"a"`, `"b"`},
+		"HomePage": {`"a.example"`},
+		"Users":    nil,
+	}
+
+	for _, class := range classes {
+		if class.Path != classPaths[class.Name] {
+			t.Errorf("Class %s: expected path %q, got %q", class.Name, classPaths[class.Name], class.Path)
+		}
+		for _, field := range class.Fields {
+			if field.Path != fieldPaths[field.Name] {
+				t.Errorf("Field %s: expected path %q, got %q", field.Name, fieldPaths[field.Name], field.Path)
+			}
+			if strings.Join(field.Examples, ",") != strings.Join(examples[field.Name], ",") {
+				t.Errorf("Field %s: expected examples %v, got %v", field.Name, examples[field.Name], field.Examples)
+			}
+		}
+	}
+}
+
+func TestParseJSONCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := parser.ParseJSON([]byte(`{"user": {"id": 1}}`), "Root", parser.WithContext(ctx))
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+}
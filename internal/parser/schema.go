@@ -0,0 +1,497 @@
+package parser
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/jguerreno/JSON-Converter/internal/conventions"
+	"github.com/jguerreno/JSON-Converter/internal/models"
+)
+
+// ParseJSONSchema converts a JSON Schema document into class definitions.
+// The root schema becomes rootName and every object under $defs or
+// definitions becomes a class named after its key. Inline objects are named
+// after their property, prefixed with the enclosing class when another
+// schema already has that name.
+func ParseJSONSchema(schemaData []byte, rootName string) ([]models.ClassDefinition, error) {
+	var doc map[string]interface{}
+	if err := json.Unmarshal(schemaData, &doc); err != nil {
+		return nil, err
+	}
+
+	converter := newSchemaConverter(doc)
+	for _, convert := range []bool{false, true} {
+		for _, key := range []string{"$defs", "definitions"} {
+			defs, _ := doc[key].(map[string]interface{})
+			for _, name := range sortedKeys(defs) {
+				if schema, ok := defs[name].(map[string]interface{}); ok {
+					converter.convertNamed(name, schemaPointer(key, name), schema, convert)
+				}
+			}
+		}
+	}
+	converter.fieldType(rootName, doc)
+
+	return converter.classes, nil
+}
+
+type schemaConverter struct {
+	doc     map[string]interface{}
+	classes []models.ClassDefinition
+	defined map[string]bool
+	// names maps the key of each schema given a class, its $ref or its
+	// inline content, to the class name; owners maps it back.
+	names  map[string]string
+	owners map[string]string
+	// parent is the class whose properties are being converted, used to
+	// name inline objects whose own name is taken.
+	parent string
+	// resolving holds the $refs being followed, so that a schema which
+	// refers back to itself is not expanded forever.
+	resolving map[string]bool
+}
+
+type schemaType struct {
+	TypeName    string
+	IsList      bool
+	IsMap       bool
+	Nullable    bool
+	Format      string
+	Enum        []string
+	Constraints *models.Constraints
+}
+
+func newSchemaConverter(doc map[string]interface{}) *schemaConverter {
+	return &schemaConverter{
+		doc:       doc,
+		defined:   make(map[string]bool),
+		names:     make(map[string]string),
+		owners:    make(map[string]string),
+		resolving: make(map[string]bool),
+	}
+}
+
+// convertNamed emits a class for a named, reusable schema found at the
+// JSON pointer ref. Schemas that are not objects are skipped: references to
+// them are inlined by fieldType. Without convert the name is only claimed,
+// so that every named schema is claimed before inline ones are named.
+func (c *schemaConverter) convertNamed(name, ref string, schema map[string]interface{}, convert bool) {
+	merged := c.mergeAllOf(schema)
+	if !isObjectSchema(merged) {
+		return
+	}
+	if !convert {
+		c.className(conventions.ToPascalCase(name), ref)
+		return
+	}
+	c.convertObject(conventions.ToPascalCase(name), ref, merged)
+}
+
+// className returns the class name of the schema identified by key: the
+// one it was already given, else name when no other schema has it, else
+// name prefixed with the enclosing class and numbered if need be.
+func (c *schemaConverter) className(name, key string) string {
+	if existing, ok := c.names[key]; ok {
+		return existing
+	}
+	candidate := name
+	if _, taken := c.owners[candidate]; taken && c.parent != "" {
+		candidate = c.parent + name
+	}
+	base := candidate
+	for i := 2; c.owners[candidate] != ""; i++ {
+		candidate = fmt.Sprintf("%s%d", base, i)
+	}
+	c.names[key] = candidate
+	c.owners[candidate] = key
+	return candidate
+}
+
+// convertObject emits the class for an object schema identified by key, a
+// $ref or, for inline schemas, inlineKey, once per key.
+func (c *schemaConverter) convertObject(name, key string, schema map[string]interface{}) string {
+	className := c.className(name, key)
+	if c.defined[className] {
+		return className
+	}
+	c.defined[className] = true
+
+	parent := c.parent
+	c.parent = className
+	defer func() { c.parent = parent }()
+
+	properties, _ := schema["properties"].(map[string]interface{})
+	required := make(map[string]bool)
+	if list, ok := schema["required"].([]interface{}); ok {
+		for _, item := range list {
+			if key, ok := item.(string); ok {
+				required[key] = true
+			}
+		}
+	}
+
+	fields := []models.FieldDefinition{}
+	for _, key := range sortedKeys(properties) {
+		propSchema, _ := properties[key].(map[string]interface{})
+		fieldName := conventions.ToPascalCase(key)
+		st := c.fieldType(fieldName, propSchema)
+
+		fields = append(fields, models.FieldDefinition{
+			Name:        fieldName,
+			JSONTag:     key,
+			TypeName:    st.TypeName,
+			IsList:      st.IsList,
+			IsMap:       st.IsMap,
+			IsOptional:  !required[key] || st.Nullable,
+			Format:      st.Format,
+			Enum:        st.Enum,
+			Constraints: st.Constraints,
+		})
+	}
+
+	c.classes = append(c.classes, models.ClassDefinition{
+		Name:   className,
+		Fields: fields,
+	})
+
+	return className
+}
+
+func (c *schemaConverter) fieldType(name string, schema map[string]interface{}) schemaType {
+	if schema == nil {
+		return schemaType{TypeName: "interface{}"}
+	}
+
+	if ref, ok := schema["$ref"].(string); ok {
+		target, refName, err := c.resolveRef(ref)
+		if err != nil {
+			return schemaType{TypeName: "interface{}"}
+		}
+		if c.resolving[ref] {
+			// A cycle: only an object class, named and defined once, can
+			// refer to itself.
+			if className, ok := c.names[ref]; ok && c.defined[className] {
+				return schemaType{TypeName: className, Nullable: isNullable(schema)}
+			}
+			return schemaType{TypeName: "interface{}", Nullable: isNullable(schema)}
+		}
+		c.resolving[ref] = true
+		defer delete(c.resolving, ref)
+
+		if merged := c.mergeAllOf(target); isObjectSchema(merged) {
+			return schemaType{
+				TypeName: c.convertObject(conventions.ToPascalCase(refName), ref, merged),
+				Nullable: isNullable(schema),
+			}
+		}
+		st := c.fieldType(refName, target)
+		st.Nullable = st.Nullable || isNullable(schema)
+		return st
+	}
+
+	if parts := schemaList(schema, "allOf"); len(parts) == 1 && !isObjectSchema(schema) {
+		st := c.fieldType(name, parts[0])
+		st.Nullable = st.Nullable || isNullable(schema)
+		return st
+	}
+
+	if variants := schemaList(schema, "oneOf", "anyOf"); variants != nil {
+		nonNull := []map[string]interface{}{}
+		for _, variant := range variants {
+			if schemaTypes(variant)[0] != "null" {
+				nonNull = append(nonNull, variant)
+			}
+		}
+		if len(nonNull) != 1 {
+			return schemaType{TypeName: "interface{}", Nullable: len(nonNull) < len(variants)}
+		}
+		st := c.fieldType(name, nonNull[0])
+		st.Nullable = st.Nullable || len(nonNull) < len(variants) || isNullable(schema)
+		return st
+	}
+
+	schema = c.mergeAllOf(schema)
+	types := schemaTypes(schema)
+	st := schemaType{Nullable: isNullable(schema), Constraints: schemaConstraints(schema)}
+
+	switch types[0] {
+	case "object":
+		if _, ok := schema["properties"]; ok {
+			className := conventions.ToPascalCase(name)
+			st.TypeName = c.convertObject(className, inlineKey(className, schema), schema)
+			return st
+		}
+		st.IsMap = true
+		st.TypeName = "interface{}"
+		if additional, ok := schema["additionalProperties"].(map[string]interface{}); ok {
+			value := c.fieldType(name+"Value", additional)
+			if !value.IsList && !value.IsMap {
+				st.TypeName = value.TypeName
+			}
+		}
+
+	case "array":
+		st.IsList = true
+		items, _ := schema["items"].(map[string]interface{})
+		item := c.fieldType(name+"Item", items)
+		st.TypeName = item.TypeName
+		if item.IsList || item.IsMap {
+			st.TypeName = "interface{}"
+		}
+
+	case "string":
+		st.TypeName = "string"
+		st.Format, _ = schema["format"].(string)
+		st.Enum = stringEnum(schema)
+
+	case "integer":
+		st.TypeName = "int"
+		if format, _ := schema["format"].(string); format == "int64" {
+			st.TypeName = "int64"
+		}
+
+	case "number":
+		st.TypeName = "float64"
+
+	case "boolean":
+		st.TypeName = "bool"
+
+	case "null":
+		st.TypeName = "interface{}"
+		st.Nullable = true
+
+	default:
+		st.TypeName = "interface{}"
+	}
+
+	return st
+}
+
+// mergeAllOf flattens an allOf composition into a single object schema so
+// that inherited properties end up on the same class.
+func (c *schemaConverter) mergeAllOf(schema map[string]interface{}) map[string]interface{} {
+	parts := schemaList(schema, "allOf")
+	if parts == nil {
+		return schema
+	}
+
+	properties := make(map[string]interface{})
+	required := []interface{}{}
+	merged := map[string]interface{}{}
+	for key, value := range schema {
+		if key != "allOf" {
+			merged[key] = value
+		}
+	}
+
+	add := func(part map[string]interface{}) {
+		if props, ok := part["properties"].(map[string]interface{}); ok {
+			for key, value := range props {
+				properties[key] = value
+			}
+		}
+		if req, ok := part["required"].([]interface{}); ok {
+			required = append(required, req...)
+		}
+	}
+
+	for _, part := range parts {
+		if ref, ok := part["$ref"].(string); ok {
+			target, _, err := c.resolveRef(ref)
+			if err != nil || c.resolving[ref] {
+				continue
+			}
+			c.resolving[ref] = true
+			add(c.mergeAllOf(target))
+			delete(c.resolving, ref)
+			continue
+		}
+		add(c.mergeAllOf(part))
+	}
+	add(schema)
+
+	if len(properties) == 0 {
+		if len(parts) == 1 {
+			return c.mergeAllOf(parts[0])
+		}
+		return merged
+	}
+	merged["type"] = "object"
+	merged["properties"] = properties
+	merged["required"] = required
+	return merged
+}
+
+// inlineKey identifies an inline object schema: the same content under the
+// same name maps to one class. fmt prints maps with sorted keys.
+func inlineKey(name string, schema map[string]interface{}) string {
+	return fmt.Sprintf("inline:%s:%v", name, schema)
+}
+
+// schemaPointer returns the JSON pointer to the schema name in the
+// document section, escaped as a $ref to it would be.
+func schemaPointer(section, name string) string {
+	name = strings.ReplaceAll(strings.ReplaceAll(name, "~", "~0"), "/", "~1")
+	return "#/" + section + "/" + name
+}
+
+// resolveRef follows a local JSON pointer such as
+// "#/components/schemas/User" and returns the target schema and its name.
+func (c *schemaConverter) resolveRef(ref string) (map[string]interface{}, string, error) {
+	if !strings.HasPrefix(ref, "#/") {
+		return nil, "", fmt.Errorf("unsupported reference %q", ref)
+	}
+
+	var current interface{} = c.doc
+	segments := strings.Split(strings.TrimPrefix(ref, "#/"), "/")
+	for _, segment := range segments {
+		segment = strings.ReplaceAll(strings.ReplaceAll(segment, "~1", "/"), "~0", "~")
+		obj, ok := current.(map[string]interface{})
+		if !ok {
+			return nil, "", fmt.Errorf("unresolvable reference %q", ref)
+		}
+		current = obj[segment]
+	}
+
+	target, ok := current.(map[string]interface{})
+	if !ok {
+		return nil, "", fmt.Errorf("unresolvable reference %q", ref)
+	}
+	return target, segments[len(segments)-1], nil
+}
+
+func isObjectSchema(schema map[string]interface{}) bool {
+	if _, ok := schema["properties"]; ok {
+		return true
+	}
+	return false
+}
+
+func isNullable(schema map[string]interface{}) bool {
+	if nullable, ok := schema["nullable"].(bool); ok && nullable {
+		return true
+	}
+	for _, t := range schemaTypes(schema) {
+		if t == "null" {
+			return true
+		}
+	}
+	return false
+}
+
+// schemaTypes returns the declared types of a schema, with the non-null type
+// first. OpenAPI 3.1 allows "type" to be a list such as ["string", "null"].
+func schemaTypes(schema map[string]interface{}) []string {
+	switch t := schema["type"].(type) {
+	case string:
+		return []string{t}
+	case []interface{}:
+		types := []string{}
+		hasNull := false
+		for _, item := range t {
+			if s, ok := item.(string); ok {
+				if s == "null" {
+					hasNull = true
+					continue
+				}
+				types = append(types, s)
+			}
+		}
+		if hasNull {
+			types = append(types, "null")
+		}
+		if len(types) > 0 {
+			return types
+		}
+	}
+
+	if _, ok := schema["properties"]; ok {
+		return []string{"object"}
+	}
+	if _, ok := schema["additionalProperties"]; ok {
+		return []string{"object"}
+	}
+	if _, ok := schema["items"]; ok {
+		return []string{"array"}
+	}
+	return []string{""}
+}
+
+func schemaList(schema map[string]interface{}, keys ...string) []map[string]interface{} {
+	for _, key := range keys {
+		list, ok := schema[key].([]interface{})
+		if !ok {
+			continue
+		}
+		result := make([]map[string]interface{}, 0, len(list))
+		for _, item := range list {
+			if s, ok := item.(map[string]interface{}); ok {
+				result = append(result, s)
+			}
+		}
+		return result
+	}
+	return nil
+}
+
+// schemaConstraints reads the validation keywords of a schema. Keywords on
+// array items are not carried over to the list field.
+func schemaConstraints(schema map[string]interface{}) *models.Constraints {
+	constraints := &models.Constraints{}
+	observed := false
+
+	intKeyword := func(key string) *int {
+		if value, ok := schema[key].(float64); ok {
+			observed = true
+			n := int(value)
+			return &n
+		}
+		return nil
+	}
+	floatKeyword := func(key string) *float64 {
+		if value, ok := schema[key].(float64); ok {
+			observed = true
+			return &value
+		}
+		return nil
+	}
+
+	constraints.MinLength = intKeyword("minLength")
+	constraints.MaxLength = intKeyword("maxLength")
+	constraints.Minimum = floatKeyword("minimum")
+	constraints.Maximum = floatKeyword("maximum")
+	constraints.MinItems = intKeyword("minItems")
+	constraints.MaxItems = intKeyword("maxItems")
+
+	if !observed {
+		return nil
+	}
+	return constraints
+}
+
+func stringEnum(schema map[string]interface{}) []string {
+	list, ok := schema["enum"].([]interface{})
+	if !ok {
+		return nil
+	}
+	values := make([]string, 0, len(list))
+	for _, item := range list {
+		if s, ok := item.(string); ok {
+			values = append(values, s)
+		}
+	}
+	return values
+}
+
+// sortedKeys returns the keys of m in order, so that fields and classes come
+// out the same way on every run.
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,46 @@
+package parser_test
+
+import (
+	"testing"
+
+	"github.com/jguerreno/JSON-Converter/internal/parser"
+)
+
+func TestParseJSONSchema(t *testing.T) {
+	schema := []byte(`{
+		"type": "object",
+		"required": ["name", "tags"],
+		"properties": {
+			"name": {"type": "string"},
+			"tags": {"type": "array", "items": {"type": "string"}},
+			"address": {"$ref": "#/$defs/address"},
+			"items": {"type": "array", "items": {"type": "object", "properties": {"sku": {"type": "string"}}}}
+		},
+		"$defs": {
+			"address": {"type": "object", "properties": {"street": {"type": "string"}}}
+		}
+	}`)
+
+	classes, err := parser.ParseJSONSchema(schema, "Order")
+	if err != nil {
+		t.Fatalf("ParseJSONSchema failed: %v", err)
+	}
+
+	order := findClass(classes, "Order")
+	if order == nil {
+		t.Fatal("Expected Order class to be created")
+	}
+	if findClass(classes, "Address") == nil {
+		t.Error("Expected Address class from $defs")
+	}
+	if findClass(classes, "ItemsItem") == nil {
+		t.Error("Expected ItemsItem class from inline array items")
+	}
+
+	if tags := findField(order, "tags"); tags == nil || !tags.IsList || tags.IsOptional {
+		t.Errorf("Expected required list field tags, got %+v", tags)
+	}
+	if address := findField(order, "address"); address == nil || address.TypeName != "Address" {
+		t.Errorf("Expected address field of type Address, got %+v", address)
+	}
+}
@@ -0,0 +1,25 @@
+package parser
+
+import (
+	"github.com/BurntSushi/toml"
+
+	"github.com/jguerreno/JSON-Converter/internal/models"
+)
+
+// ParseTOML infers class definitions from a TOML document. Datetimes are
+// treated as strings, as they would be in the equivalent JSON.
+func ParseTOML(tomlData []byte, rootName string, opts ...Option) ([]models.ClassDefinition, error) {
+	p, err := newProcessor(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var data map[string]interface{}
+	if err := toml.Unmarshal(tomlData, &data); err != nil {
+		return nil, err
+	}
+
+	p.processValue(rootName, normalizeValue(data), rootPath)
+
+	return p.result()
+}
@@ -0,0 +1,187 @@
+package parser
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/jguerreno/JSON-Converter/internal/conventions"
+	"github.com/jguerreno/JSON-Converter/internal/models"
+)
+
+// Keys used to carry attributes and text content through the JSON inference
+// pipeline before they are turned back into XML-aware fields.
+const (
+	xmlAttrPrefix = "@"
+	xmlTextKey    = "#text"
+)
+
+type xmlNode struct {
+	name     string
+	attrs    []xml.Attr
+	children []*xmlNode
+	text     strings.Builder
+}
+
+// ParseXML infers class definitions from an XML document. Elements become
+// nested classes, repeated siblings become lists, attributes become fields
+// and text next to attributes or child elements becomes a Value field.
+func ParseXML(xmlData []byte, rootName string, opts ...Option) ([]models.ClassDefinition, error) {
+	p, err := newProcessor(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	root, err := decodeXMLTree(xmlData)
+	if err != nil {
+		return nil, err
+	}
+
+	value := xmlNodeValue(root)
+	if _, isObject := value.(map[string]interface{}); !isObject {
+		value = map[string]interface{}{xmlTextKey: value}
+	}
+
+	p.processValue(rootName, value, rootPath)
+	classes, err := p.result()
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range classes {
+		classes[i].Fields = xmlFields(classes[i].Fields)
+	}
+	classes[len(classes)-1].XMLName = root.name
+
+	return classes, nil
+}
+
+func decodeXMLTree(xmlData []byte) (*xmlNode, error) {
+	decoder := xml.NewDecoder(bytes.NewReader(xmlData))
+	var root *xmlNode
+	stack := []*xmlNode{}
+
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			node := &xmlNode{name: t.Name.Local, attrs: t.Attr}
+			if len(stack) == 0 {
+				if root != nil {
+					return nil, fmt.Errorf("multiple root elements")
+				}
+				root = node
+			} else {
+				parent := stack[len(stack)-1]
+				parent.children = append(parent.children, node)
+			}
+			stack = append(stack, node)
+		case xml.EndElement:
+			stack = stack[:len(stack)-1]
+		case xml.CharData:
+			if len(stack) > 0 {
+				stack[len(stack)-1].text.Write(t)
+			}
+		}
+	}
+
+	if root == nil {
+		return nil, fmt.Errorf("no root element found")
+	}
+	return root, nil
+}
+
+// xmlNodeValue converts an element into the generic shape produced by
+// encoding/json, so that samples are merged by the usual inference rules.
+func xmlNodeValue(node *xmlNode) interface{} {
+	text := strings.TrimSpace(node.text.String())
+	attrs := []xml.Attr{}
+	for _, attr := range node.attrs {
+		if attr.Name.Space != "xmlns" && attr.Name.Local != "xmlns" {
+			attrs = append(attrs, attr)
+		}
+	}
+
+	if len(attrs) == 0 && len(node.children) == 0 {
+		return xmlScalar(text)
+	}
+
+	obj := make(map[string]interface{})
+	for _, attr := range attrs {
+		obj[xmlAttrPrefix+attr.Name.Local] = xmlScalar(attr.Value)
+	}
+
+	groups := make(map[string][]interface{})
+	for _, child := range node.children {
+		groups[child.name] = append(groups[child.name], xmlNodeValue(child))
+	}
+	for name, values := range groups {
+		if len(values) > 1 {
+			obj[name] = values
+		} else {
+			obj[name] = values[0]
+		}
+	}
+
+	if text != "" {
+		obj[xmlTextKey] = xmlScalar(text)
+	}
+	return obj
+}
+
+func xmlScalar(text string) interface{} {
+	if text == "" {
+		return nil
+	}
+	if n, err := strconv.ParseInt(text, 10, 64); err == nil {
+		return float64(n)
+	}
+	if f, err := strconv.ParseFloat(text, 64); err == nil {
+		return f
+	}
+	if text == "true" || text == "false" {
+		return text == "true"
+	}
+	return text
+}
+
+// xmlFields restores attribute and text fields from their marker keys.
+func xmlFields(fields []models.FieldDefinition) []models.FieldDefinition {
+	taken := make(map[string]bool)
+	for _, field := range fields {
+		if !strings.HasPrefix(field.JSONTag, xmlAttrPrefix) && field.JSONTag != xmlTextKey {
+			taken[field.Name] = true
+		}
+	}
+
+	for i := range fields {
+		field := &fields[i]
+		switch {
+		case field.JSONTag == xmlTextKey:
+			field.Name = "Value"
+			field.JSONTag = "value"
+			field.XMLKind = models.XMLCharData
+		case strings.HasPrefix(field.JSONTag, xmlAttrPrefix):
+			field.JSONTag = strings.TrimPrefix(field.JSONTag, xmlAttrPrefix)
+			field.Name = conventions.ToPascalCase(field.JSONTag)
+			if taken[field.Name] {
+				field.Name += "Attr"
+			}
+			field.XMLKind = models.XMLAttribute
+		default:
+			field.XMLKind = models.XMLElement
+		}
+	}
+	return fields
+}
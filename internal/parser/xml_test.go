@@ -0,0 +1,84 @@
+package parser_test
+
+import (
+	"testing"
+
+	"github.com/jguerreno/JSON-Converter/internal/models"
+	"github.com/jguerreno/JSON-Converter/internal/parser"
+)
+
+func TestParseXML(t *testing.T) {
+	xmlData := []byte(`<?xml version="1.0"?>
+<order id="42" xmlns="urn:orders">
+	<customer vip="true">Alice</customer>
+	<item sku="A1"><qty>2</qty></item>
+	<item sku="B2"><qty>1</qty><note>gift</note></item>
+	<total>19.90</total>
+</order>`)
+
+	classes, err := parser.ParseXML(xmlData, "Order")
+	if err != nil {
+		t.Fatalf("ParseXML failed: %v", err)
+	}
+
+	order := findClass(classes, "Order")
+	if order == nil {
+		t.Fatal("Expected Order class to be created")
+	}
+	if order.XMLName != "order" {
+		t.Errorf("Expected root XMLName 'order', got %q", order.XMLName)
+	}
+
+	tests := []struct {
+		jsonTag  string
+		typeName string
+		isList   bool
+		xmlKind  string
+	}{
+		{"id", "int", false, models.XMLAttribute},
+		{"customer", "Customer", false, models.XMLElement},
+		{"item", "ItemItem", true, models.XMLElement},
+		{"total", "float64", false, models.XMLElement},
+	}
+	for _, tt := range tests {
+		field := findField(order, tt.jsonTag)
+		if field == nil {
+			t.Errorf("Order is missing field %q", tt.jsonTag)
+			continue
+		}
+		if field.TypeName != tt.typeName || field.IsList != tt.isList || field.XMLKind != tt.xmlKind {
+			t.Errorf("Order.%s = %+v, want type %s list %v kind %s", tt.jsonTag, field, tt.typeName, tt.isList, tt.xmlKind)
+		}
+	}
+	if findField(order, "xmlns") != nil {
+		t.Error("Namespace declarations should not become fields")
+	}
+
+	customer := findClass(classes, "Customer")
+	if customer == nil {
+		t.Fatal("Expected Customer class to be created")
+	}
+	if value := findField(customer, "value"); value == nil || value.Name != "Value" || value.XMLKind != models.XMLCharData {
+		t.Errorf("Expected chardata Value field on Customer, got %+v", value)
+	}
+	if vip := findField(customer, "vip"); vip == nil || vip.TypeName != "bool" || vip.XMLKind != models.XMLAttribute {
+		t.Errorf("Expected bool attribute vip on Customer, got %+v", vip)
+	}
+
+	item := findClass(classes, "ItemItem")
+	if item == nil {
+		t.Fatal("Expected ItemItem class to be created")
+	}
+	if note := findField(item, "note"); note == nil || !note.IsOptional {
+		t.Error("Field 'note' SHOULD be optional (missing from one item)")
+	}
+}
+
+func TestParseXMLInvalid(t *testing.T) {
+	if _, err := parser.ParseXML([]byte(`<a><b></a>`), "Root"); err == nil {
+		t.Error("Expected error for malformed XML")
+	}
+	if _, err := parser.ParseXML([]byte(``), "Root"); err == nil {
+		t.Error("Expected error for empty document")
+	}
+}
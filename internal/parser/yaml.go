@@ -0,0 +1,39 @@
+package parser
+
+import (
+	"bytes"
+	"errors"
+	"io"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/jguerreno/JSON-Converter/internal/models"
+)
+
+// ParseYAML infers class definitions from a YAML document. A multi-document
+// stream is treated as several samples of the same root type.
+func ParseYAML(yamlData []byte, rootName string, opts ...Option) ([]models.ClassDefinition, error) {
+	p, err := newProcessor(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := yaml.NewDecoder(bytes.NewReader(yamlData))
+	samples := []interface{}{}
+	for {
+		var doc interface{}
+		if err := decoder.Decode(&doc); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, err
+		}
+		if doc != nil {
+			samples = append(samples, normalizeValue(doc))
+		}
+	}
+
+	p.processSamples(rootName, samples, rootPath)
+
+	return p.result()
+}
@@ -0,0 +1,129 @@
+"use strict";
+
+// The playground regenerates the output whenever the sample or a setting
+// changes, through the same /api endpoints the server offers to clients.
+
+const elements = {
+  language: document.getElementById("language"),
+  format: document.getElementById("format"),
+  root: document.getElementById("root"),
+  options: document.getElementById("options"),
+  input: document.getElementById("input"),
+  output: document.getElementById("output"),
+  status: document.getElementById("status"),
+  download: document.getElementById("download"),
+};
+
+let lastResult = null;
+let pending = null;
+let timer = null;
+
+async function loadLanguages() {
+  const response = await fetch("api/languages");
+  const { languages } = await response.json();
+  for (const language of languages) {
+    const option = document.createElement("option");
+    option.value = language.name;
+    option.textContent = language.name;
+    elements.language.append(option);
+  }
+  elements.language.value = "go";
+}
+
+function generateURL() {
+  const params = new URLSearchParams({
+    language: elements.language.value,
+    format: elements.format.value,
+    root: elements.root.value || "Root",
+  });
+  // Options are separated by commas, but a value may hold commas of its own
+  // (tags=yaml,toml), so only a comma that starts a new key=value splits.
+  for (const pair of elements.options.value.split(/\n|,(?=\s*[\w.-]+=)/)) {
+    if (pair.trim() !== "") {
+      params.append("opt", pair.trim());
+    }
+  }
+  return "api/generate?" + params;
+}
+
+function fileName(response, fallback) {
+  const match = /filename="([^"]+)"/.exec(response.headers.get("Content-Disposition") || "");
+  return match ? match[1] : fallback;
+}
+
+async function generate() {
+  if (pending) {
+    pending.abort();
+  }
+  pending = new AbortController();
+  elements.status.textContent = "generating…";
+
+  try {
+    const response = await fetch(generateURL(), {
+      method: "POST",
+      body: elements.input.value,
+      signal: pending.signal,
+    });
+    const contentType = response.headers.get("Content-Type") || "";
+
+    if (!response.ok) {
+      const { error } = await response.json();
+      showError(error.message);
+      return;
+    }
+    if (contentType.startsWith("application/zip")) {
+      const blob = await response.blob();
+      lastResult = { blob, name: fileName(response, "generated.zip") };
+      showOutput(`Generated several files (${blob.size} bytes), use Download to get the archive.`);
+      return;
+    }
+
+    const text = await response.text();
+    lastResult = { blob: new Blob([text], { type: "text/plain" }), name: fileName(response, "models.txt") };
+    showOutput(text);
+  } catch (err) {
+    if (err.name !== "AbortError") {
+      showError(err.message);
+    }
+  }
+}
+
+function showOutput(text) {
+  elements.output.classList.remove("error");
+  elements.output.textContent = text;
+  elements.status.textContent = lastResult.name;
+  elements.download.disabled = false;
+}
+
+function showError(message) {
+  lastResult = null;
+  elements.output.classList.add("error");
+  elements.output.textContent = message;
+  elements.status.textContent = "error";
+  elements.download.disabled = true;
+}
+
+function scheduleGenerate() {
+  clearTimeout(timer);
+  timer = setTimeout(generate, 300);
+}
+
+elements.download.addEventListener("click", () => {
+  if (!lastResult) {
+    return;
+  }
+  const link = document.createElement("a");
+  link.href = URL.createObjectURL(lastResult.blob);
+  link.download = lastResult.name;
+  link.click();
+  URL.revokeObjectURL(link.href);
+});
+
+for (const element of [elements.input, elements.root, elements.options]) {
+  element.addEventListener("input", scheduleGenerate);
+}
+for (const element of [elements.language, elements.format]) {
+  element.addEventListener("change", generate);
+}
+
+loadLanguages().then(generate, (err) => showError(err.message));
@@ -0,0 +1,245 @@
+package server
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"runtime"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/jguerreno/JSON-Converter/converter"
+)
+
+//go:embed playground
+var playgroundFiles embed.FS
+
+// Defaults for Config fields left at zero.
+const (
+	DefaultMaxBodyBytes = 1 << 20
+	DefaultTimeout      = 10 * time.Second
+)
+
+// Config tunes a Server.
+type Config struct {
+	// Registry holds the languages offered; nil means the built-in ones.
+	Registry *converter.Registry
+	// MaxBodyBytes caps the size of a posted document.
+	MaxBodyBytes int64
+	// Timeout bounds the time spent generating code for one request,
+	// including the wait for a free slot.
+	Timeout time.Duration
+	// MaxConcurrent caps the generations running at once; zero means the
+	// number of CPUs. Requests beyond it wait for a slot.
+	MaxConcurrent int
+}
+
+// Server exposes the converter over HTTP:
+//
+//	GET  /               a browser playground built on the endpoints below
+//	GET  /api/languages  the available languages and their file extensions
+//	POST /api/generate   code for the posted document
+//
+// /api/generate takes the query parameters language (default "go"), root
+// (default "Root"), format (default "json") and opt=key=value, repeatable.
+// A single generated file is returned as text, several as a zip archive.
+// Errors are JSON objects of the form {"error": {"code": ..., "message": ...}}.
+type Server struct {
+	registry     *converter.Registry
+	maxBodyBytes int64
+	timeout      time.Duration
+	slots        chan struct{}
+	mux          *http.ServeMux
+}
+
+func NewServer(config Config) *Server {
+	s := &Server{
+		registry:     config.Registry,
+		maxBodyBytes: config.MaxBodyBytes,
+		timeout:      config.Timeout,
+		mux:          http.NewServeMux(),
+	}
+	if s.registry == nil {
+		s.registry = converter.NewRegistry()
+	}
+	if s.maxBodyBytes <= 0 {
+		s.maxBodyBytes = DefaultMaxBodyBytes
+	}
+	if s.timeout <= 0 {
+		s.timeout = DefaultTimeout
+	}
+	maxConcurrent := config.MaxConcurrent
+	if maxConcurrent <= 0 {
+		maxConcurrent = runtime.NumCPU()
+	}
+	s.slots = make(chan struct{}, maxConcurrent)
+
+	s.mux.HandleFunc("GET /api/languages", s.handleLanguages)
+	s.mux.HandleFunc("POST /api/generate", s.handleGenerate)
+	s.mux.HandleFunc("/api/", func(w http.ResponseWriter, r *http.Request) {
+		writeError(w, http.StatusNotFound, "not_found", fmt.Sprintf("no endpoint for %s %s", r.Method, r.URL.Path))
+	})
+
+	playground, _ := fs.Sub(playgroundFiles, "playground")
+	s.mux.Handle("/", http.FileServer(http.FS(playground)))
+	return s
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mux.ServeHTTP(w, r)
+}
+
+// Language describes one entry of /api/languages.
+type Language struct {
+	Name      string `json:"name"`
+	Extension string `json:"extension"`
+}
+
+func (s *Server) handleLanguages(w http.ResponseWriter, r *http.Request) {
+	names := s.registry.GetSupportedLanguages()
+	sort.Strings(names)
+
+	languages := make([]Language, 0, len(names))
+	for _, name := range names {
+		gen, err := s.registry.GetLanguage(name)
+		if err != nil {
+			continue
+		}
+		languages = append(languages, Language{Name: name, Extension: gen.GetFileExtension()})
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{"languages": languages})
+}
+
+func (s *Server) handleGenerate(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	language := queryValue(query.Get("language"), "go")
+	if _, err := s.registry.GetLanguage(language); err != nil {
+		writeError(w, http.StatusNotFound, "unknown_language", err.Error())
+		return
+	}
+	format, err := converter.ParseFormat(queryValue(query.Get("format"), string(converter.FormatJSON)))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid_format", err.Error())
+		return
+	}
+	options := converter.Options{}
+	for _, pair := range query["opt"] {
+		key, value, ok := strings.Cut(pair, "=")
+		if !ok || key == "" {
+			writeError(w, http.StatusBadRequest, "invalid_option", fmt.Sprintf("expected opt=key=value, got %q", pair))
+			return
+		}
+		options[key] = value
+	}
+
+	data, err := io.ReadAll(http.MaxBytesReader(w, r.Body, s.maxBodyBytes))
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			writeError(w, http.StatusRequestEntityTooLarge, "body_too_large", fmt.Sprintf("request body exceeds %d bytes", s.maxBodyBytes))
+			return
+		}
+		writeError(w, http.StatusBadRequest, "invalid_body", err.Error())
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), s.timeout)
+	defer cancel()
+
+	select {
+	case s.slots <- struct{}{}:
+		defer func() { <-s.slots }()
+	case <-ctx.Done():
+		writeError(w, http.StatusServiceUnavailable, "busy", fmt.Sprintf("no generation slot freed up within %s", s.timeout))
+		return
+	}
+
+	opts := []converter.Option{
+		converter.WithRegistry(s.registry),
+		converter.WithFormat(format),
+		converter.WithRootName(queryValue(query.Get("root"), "Root")),
+		converter.WithOptions(options),
+	}
+	classes, err := converter.Parse(ctx, data, opts...)
+	if err != nil {
+		s.writeGenerateError(w, err, http.StatusBadRequest, "invalid_input")
+		return
+	}
+	files, err := converter.GenerateFromModel(ctx, classes, language, opts...)
+	if err != nil {
+		s.writeGenerateError(w, err, http.StatusUnprocessableEntity, "generation_failed")
+		return
+	}
+
+	if len(files) == 1 {
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("inline; filename=%q", files[0].Name))
+		io.WriteString(w, files[0].Content)
+		return
+	}
+
+	archive, err := zipFiles(files)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "internal", err.Error())
+		return
+	}
+	w.Header().Set("Content-Type", "application/zip")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", language+".zip"))
+	w.Write(archive)
+}
+
+func (s *Server) writeGenerateError(w http.ResponseWriter, err error, status int, code string) {
+	if errors.Is(err, context.DeadlineExceeded) {
+		writeError(w, http.StatusGatewayTimeout, "timeout", fmt.Sprintf("generation did not finish within %s", s.timeout))
+		return
+	}
+	writeError(w, status, code, err.Error())
+}
+
+func zipFiles(files []converter.GeneratedFile) ([]byte, error) {
+	var buf bytes.Buffer
+	archive := zip.NewWriter(&buf)
+	for _, file := range files {
+		entry, err := archive.Create(file.Name)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := io.WriteString(entry, file.Content); err != nil {
+			return nil, err
+		}
+	}
+	if err := archive.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func queryValue(value, fallback string) string {
+	if value == "" {
+		return fallback
+	}
+	return value
+}
+
+type errorBody struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+func writeError(w http.ResponseWriter, status int, code, message string) {
+	writeJSON(w, status, map[string]errorBody{"error": {Code: code, Message: message}})
+}
+
+func writeJSON(w http.ResponseWriter, status int, value interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(value)
+}
@@ -0,0 +1,213 @@
+package server
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jguerreno/JSON-Converter/converter"
+	"github.com/jguerreno/JSON-Converter/internal/generator"
+	"github.com/jguerreno/JSON-Converter/internal/models"
+)
+
+// fakeGenerator emits one file per class and can be slowed down to trip
+// the request timeout.
+type fakeGenerator struct {
+	delay time.Duration
+}
+
+func (f fakeGenerator) GetName() string          { return "fake" }
+func (f fakeGenerator) GetFileExtension() string { return "txt" }
+
+func (f fakeGenerator) Generate(classes []models.ClassDefinition) (string, error) {
+	return f.GenerateWithOptions(classes, nil)
+}
+
+func (f fakeGenerator) GenerateWithOptions(classes []models.ClassDefinition, opts models.Options) (string, error) {
+	return "", nil
+}
+
+func (f fakeGenerator) GenerateFiles(classes []models.ClassDefinition, opts models.Options) ([]generator.GeneratedFile, error) {
+	return f.GenerateFilesContext(context.Background(), classes, opts)
+}
+
+func (f fakeGenerator) GenerateFilesContext(ctx context.Context, classes []models.ClassDefinition, opts models.Options) ([]generator.GeneratedFile, error) {
+	select {
+	case <-time.After(f.delay):
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	files := []generator.GeneratedFile{}
+	for _, class := range classes {
+		files = append(files, generator.GeneratedFile{Name: class.Name + ".txt", Content: class.Name})
+	}
+	return files, nil
+}
+
+func newTestServer(config Config, gen generator.LanguageGenerator) *Server {
+	config.Registry = converter.NewRegistry()
+	if gen != nil {
+		config.Registry.Register(gen)
+	}
+	return NewServer(config)
+}
+
+func decodeError(t *testing.T, body *bytes.Buffer) errorBody {
+	t.Helper()
+	var response map[string]errorBody
+	if err := json.Unmarshal(body.Bytes(), &response); err != nil {
+		t.Fatalf("error response is not JSON: %v\n%s", err, body.String())
+	}
+	return response["error"]
+}
+
+func TestServer_Languages(t *testing.T) {
+	recorder := httptest.NewRecorder()
+	newTestServer(Config{}, nil).ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/api/languages", nil))
+
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200", recorder.Code)
+	}
+	var response struct {
+		Languages []Language `json:"languages"`
+	}
+	if err := json.Unmarshal(recorder.Body.Bytes(), &response); err != nil {
+		t.Fatal(err)
+	}
+	found := false
+	for _, language := range response.Languages {
+		found = found || language == Language{Name: "typescript", Extension: "ts"}
+	}
+	if !found {
+		t.Errorf("typescript missing from %v", response.Languages)
+	}
+}
+
+func TestServer_Generate(t *testing.T) {
+	recorder := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodPost, "/api/generate?language=typescript&root=User&opt=style=zod", strings.NewReader(`{"id": 1}`))
+	newTestServer(Config{}, nil).ServeHTTP(recorder, request)
+
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200: %s", recorder.Code, recorder.Body.String())
+	}
+	if contentType := recorder.Header().Get("Content-Type"); !strings.HasPrefix(contentType, "text/plain") {
+		t.Errorf("Content-Type = %q, want text/plain", contentType)
+	}
+	if !strings.Contains(recorder.Body.String(), "export const UserSchema = z.object({") {
+		t.Errorf("unexpected body:\n%s", recorder.Body.String())
+	}
+}
+
+func TestServer_GenerateZip(t *testing.T) {
+	recorder := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodPost, "/api/generate?language=fake", strings.NewReader(`{"child": {"id": 1}}`))
+	newTestServer(Config{}, fakeGenerator{}).ServeHTTP(recorder, request)
+
+	if recorder.Code != http.StatusOK || recorder.Header().Get("Content-Type") != "application/zip" {
+		t.Fatalf("status = %d, Content-Type = %q", recorder.Code, recorder.Header().Get("Content-Type"))
+	}
+	archive, err := zip.NewReader(bytes.NewReader(recorder.Body.Bytes()), int64(recorder.Body.Len()))
+	if err != nil {
+		t.Fatalf("response is not a zip archive: %v", err)
+	}
+	names := []string{}
+	for _, file := range archive.File {
+		names = append(names, file.Name)
+	}
+	if strings.Join(names, ",") != "Child.txt,Root.txt" {
+		t.Errorf("archive files = %v", names)
+	}
+}
+
+func TestServer_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		method   string
+		target   string
+		body     string
+		config   Config
+		wantCode int
+		wantErr  string
+	}{
+		{"unknown language", http.MethodPost, "/api/generate?language=cobol", `{}`, Config{}, http.StatusNotFound, "unknown_language"},
+		{"invalid format", http.MethodPost, "/api/generate?format=ini", `{}`, Config{}, http.StatusBadRequest, "invalid_format"},
+		{"invalid option", http.MethodPost, "/api/generate?opt=style", `{}`, Config{}, http.StatusBadRequest, "invalid_option"},
+		{"invalid input", http.MethodPost, "/api/generate", `{"a":`, Config{}, http.StatusBadRequest, "invalid_input"},
+		{"generation failure", http.MethodPost, "/api/generate?language=python&opt=style=django", `{}`, Config{}, http.StatusUnprocessableEntity, "generation_failed"},
+		{"body too large", http.MethodPost, "/api/generate", `{"name": "Alice"}`, Config{MaxBodyBytes: 8}, http.StatusRequestEntityTooLarge, "body_too_large"},
+		{"timeout", http.MethodPost, "/api/generate?language=fake", `{}`, Config{Timeout: 10 * time.Millisecond}, http.StatusGatewayTimeout, "timeout"},
+		{"unknown endpoint", http.MethodGet, "/api/generate", "", Config{}, http.StatusNotFound, "not_found"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+			request := httptest.NewRequest(tt.method, tt.target, strings.NewReader(tt.body))
+			newTestServer(tt.config, fakeGenerator{delay: 200 * time.Millisecond}).ServeHTTP(recorder, request)
+
+			if recorder.Code != tt.wantCode {
+				t.Errorf("status = %d, want %d", recorder.Code, tt.wantCode)
+			}
+			if body := decodeError(t, recorder.Body); body.Code != tt.wantErr || body.Message == "" {
+				t.Errorf("error = %+v, want code %q", body, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestServer_LimitsConcurrentGenerations(t *testing.T) {
+	s := newTestServer(Config{Timeout: 20 * time.Millisecond, MaxConcurrent: 1}, fakeGenerator{})
+	s.slots <- struct{}{}
+
+	recorder := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodPost, "/api/generate?language=fake", strings.NewReader(`{}`))
+	s.ServeHTTP(recorder, request)
+	if recorder.Code != http.StatusServiceUnavailable {
+		t.Errorf("status = %d, want %d", recorder.Code, http.StatusServiceUnavailable)
+	}
+	if body := decodeError(t, recorder.Body); body.Code != "busy" {
+		t.Errorf("error = %+v, want code busy", body)
+	}
+
+	<-s.slots
+	recorder = httptest.NewRecorder()
+	request = httptest.NewRequest(http.MethodPost, "/api/generate?language=fake", strings.NewReader(`{}`))
+	s.ServeHTTP(recorder, request)
+	if recorder.Code != http.StatusOK {
+		t.Errorf("status = %d once the slot is free, want %d", recorder.Code, http.StatusOK)
+	}
+}
+
+func TestServer_Playground(t *testing.T) {
+	tests := []struct {
+		path        string
+		contentType string
+		want        string
+	}{
+		{"/", "text/html", `<script src="playground.js"></script>`},
+		{"/playground.js", "javascript", `fetch("api/languages")`},
+		{"/playground.css", "text/css", "grid-template-columns"},
+	}
+
+	for _, tt := range tests {
+		recorder := httptest.NewRecorder()
+		newTestServer(Config{}, nil).ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, tt.path, nil))
+
+		if recorder.Code != http.StatusOK {
+			t.Errorf("GET %s status = %d, want 200", tt.path, recorder.Code)
+		}
+		if contentType := recorder.Header().Get("Content-Type"); !strings.Contains(contentType, tt.contentType) {
+			t.Errorf("GET %s Content-Type = %q, want %s", tt.path, contentType, tt.contentType)
+		}
+		if !strings.Contains(recorder.Body.String(), tt.want) {
+			t.Errorf("GET %s body missing %q", tt.path, tt.want)
+		}
+	}
+}